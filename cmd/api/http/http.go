@@ -6,9 +6,15 @@ import (
 
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/organizations"
+	permissionRoutes "github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/permissions"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/roles"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/scim"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/security"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/users"
 	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing/bodies"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing/parameters"
@@ -27,30 +33,62 @@ type HttpRouter interface {
 type httpRouter struct {
 	storage    storage.Storage
 	middleware middleware.Middleware
+	mail       mail.Sender
 	openai     openai.Client
+	catalog    *permissions.Catalog
 	routes     []routing.Route
 }
 
-func New(storage storage.Storage, middleware middleware.Middleware, openai openai.Client) HttpRouter {
-	authenticationRouter := authentication.New(storage, middleware)
+func New(storage storage.Storage, middleware middleware.Middleware, mail mail.Sender, openai openai.Client) HttpRouter {
+	catalog := permissions.NewCatalog()
+
+	authenticationRouter := authentication.New(storage, middleware, mail)
 	authenticationRoutes := authenticationRouter.LoadRoutes()
 
 	usersRouter := users.New(storage, middleware)
 	usersRoutes := usersRouter.LoadRoutes()
 
-	rolesRouter := roles.New(storage, middleware)
+	rolesRouter := roles.New(storage, middleware, catalog)
 	rolesRoutes := rolesRouter.LoadRoutes()
 
+	organizationsRouter := organizations.New(storage, middleware, mail)
+	organizationsRoutes := organizationsRouter.LoadRoutes()
+
+	securityRouter := security.New(storage, middleware)
+	securityRoutes := securityRouter.LoadRoutes()
+
+	scimRouter := scim.New(storage, middleware)
+	scimRoutes := scimRouter.LoadRoutes()
+
+	permissionsRouter := permissionRoutes.New(storage, middleware, catalog)
+	permissionsRoutes := permissionsRouter.LoadRoutes()
+
 	routes := []routing.Route{}
 
 	routes = append(routes, authenticationRoutes...)
 	routes = append(routes, usersRoutes...)
 	routes = append(routes, rolesRoutes...)
+	routes = append(routes, organizationsRoutes...)
+	routes = append(routes, securityRoutes...)
+	routes = append(routes, scimRoutes...)
+	routes = append(routes, permissionsRoutes...)
+
+	for _, route := range routes {
+		for _, permission := range route.Permissions {
+			catalog.Register(
+				permission,
+				route.Summary,
+				fmt.Sprintf("%s /api/v1%s", route.Method, route.Path),
+			)
+		}
+	}
 
 	return &httpRouter{
 		storage:    storage,
 		middleware: middleware,
+		mail:       mail,
 		openai:     openai,
+		catalog:    catalog,
 		routes:     routes,
 	}
 }
@@ -59,7 +97,23 @@ func (h *httpRouter) InitializeRoutes(router fiber.Router) {
 	for _, route := range h.routes {
 		path := regexp.MustCompile(`\{([^}]+)\}`).ReplaceAllString(route.Path, ":$1")
 
-		routes := route.Middlewares
+		bodyLimit := route.BodyLimit
+
+		if bodyLimit == 0 {
+			bodyLimit = common.EnvInt("API_BODY_LIMIT", 1024*1024)
+		}
+
+		routes := []fiber.Handler{h.middleware.BodyLimit(bodyLimit)}
+		routes = append(routes, route.Middlewares...)
+
+		if len(route.Permissions) > 0 && !route.CustomAuthorization {
+			routes = append(routes, h.middleware.Authorized(route.Permissions...))
+		}
+
+		if len(route.Permissions) == 0 && !route.ApiTokens {
+			routes = append(routes, h.middleware.Interactive())
+		}
+
 		routes = append(routes, route.Handler)
 
 		switch route.Method {
@@ -91,23 +145,69 @@ func (h *httpRouter) InitializeOpenAPI() *openapi3.T {
 		"Code":              parameters.CodeParameter,
 		"State":             parameters.StateParameter,
 		"To":                parameters.ToParameter,
+		"Name":              parameters.NameParameter,
+		"Provider":          parameters.ProviderParameter,
+		"SystemWide":        parameters.SystemWideParameter,
 	}
 
 	bodies := openapi3.RequestBodies{
-		"CreateUserPayload": bodies.CreateUserSchema,
-		"UpdateUserPayload": bodies.UpdateUserSchema,
-		"CreateRolePayload": bodies.CreateRoleSchema,
-		"UpdateRolePayload": bodies.UpdateRoleSchema,
+		"LoginPayload":                  bodies.LoginSchema,
+		"RegisterPayload":               bodies.RegisterSchema,
+		"VerifyEmailPayload":            bodies.VerifyEmailSchema,
+		"ResendVerificationPayload":     bodies.ResendVerificationSchema,
+		"RequestPasswordResetPayload":   bodies.RequestPasswordResetSchema,
+		"ConfirmPasswordResetPayload":   bodies.ConfirmPasswordResetSchema,
+		"ChangePasswordPayload":         bodies.ChangePasswordSchema,
+		"VerifyRecoveryCodePayload":     bodies.VerifyRecoveryCodeSchema,
+		"RequestPasswordlessPayload":    bodies.RequestPasswordlessSchema,
+		"VerifyPasswordlessLinkPayload": bodies.VerifyPasswordlessLinkSchema,
+		"VerifyPasswordlessCodePayload": bodies.VerifyPasswordlessCodeSchema,
+		"WebauthnCredentialPayload":     bodies.WebauthnCredentialSchema,
+		"CreateApiTokenPayload":         bodies.CreateApiTokenSchema,
+		"StartImpersonationPayload":     bodies.StartImpersonationSchema,
+		"SwitchOrganizationPayload":     bodies.SwitchOrganizationSchema,
+		"RefreshTokenPayload":           bodies.RefreshTokenSchema,
+		"PasswordGrantPayload":          bodies.PasswordGrantSchema,
+		"CreateScimTokenPayload":        bodies.CreateScimTokenSchema,
+		"CreateInvitationPayload":       bodies.CreateInvitationSchema,
+		"AcceptInvitationPayload":       bodies.AcceptInvitationSchema,
+		"ScimUserPayload":               bodies.ScimUserSchema,
+		"ScimGroupPayload":              bodies.ScimGroupSchema,
+		"ScimPatchPayload":              bodies.ScimPatchSchema,
+		"CreateUserPayload":             bodies.CreateUserSchema,
+		"UpdateUserPayload":             bodies.UpdateUserSchema,
+		"UpdateUserTypePayload":         bodies.UpdateUserTypeSchema,
+		"CreateRolePayload":             bodies.CreateRoleSchema,
+		"UpdateRolePayload":             bodies.UpdateRoleSchema,
 	}
 
 	schemas := openapi3.Schemas{
-		"SuccessResponse": schemas.SuccessSchema,
-		"ErrorResponse":   schemas.ErrorSchema,
-		"Pagination":      schemas.PaginationSchema,
-		"User":            schemas.UserSchema,
-		"Users":           schemas.UsersSchema,
-		"Role":            schemas.RoleSchema,
-		"Roles":           schemas.RolesSchema,
+		"SuccessResponse":         schemas.SuccessSchema,
+		"ErrorResponse":           schemas.ErrorSchema,
+		"Pagination":              schemas.PaginationSchema,
+		"User":                    schemas.UserSchema,
+		"Users":                   schemas.UsersSchema,
+		"Role":                    schemas.RoleSchema,
+		"Roles":                   schemas.RolesSchema,
+		"Organization":            schemas.OrganizationSchema,
+		"Organizations":           schemas.OrganizationsSchema,
+		"WebauthnCredential":      schemas.WebauthnCredentialSchema,
+		"WebauthnCredentials":     schemas.WebauthnCredentialsSchema,
+		"ApiToken":                schemas.ApiTokenSchema,
+		"ApiTokens":               schemas.ApiTokensSchema,
+		"UserSession":             schemas.UserSessionSchema,
+		"UserSessions":            schemas.UserSessionsSchema,
+		"LockoutEvent":            schemas.LockoutEventSchema,
+		"LockoutEvents":           schemas.LockoutEventsSchema,
+		"ImpersonationEvent":      schemas.ImpersonationEventSchema,
+		"ImpersonationEvents":     schemas.ImpersonationEventsSchema,
+		"ScimToken":               schemas.ScimTokenSchema,
+		"ScimTokens":              schemas.ScimTokensSchema,
+		"OrganizationInvitation":  schemas.OrganizationInvitationSchema,
+		"OrganizationInvitations": schemas.OrganizationInvitationsSchema,
+		"Permission":              schemas.PermissionSchema,
+		"PermissionGroup":         schemas.PermissionGroupSchema,
+		"PermissionGroups":        schemas.PermissionGroupsSchema,
 	}
 
 	for _, route := range h.routes {
@@ -159,6 +259,14 @@ func (h *httpRouter) InitializeOpenAPI() *openapi3.T {
 			}
 		}
 
+		if len(route.Permissions) > 0 {
+			for _, operation := range pathItem.Operations() {
+				operation.Extensions = map[string]any{
+					"x-permissions": route.Permissions,
+				}
+			}
+		}
+
 		path := fmt.Sprintf("/api/v1%s", route.Path)
 
 		existingPathItem := paths.Find(path)
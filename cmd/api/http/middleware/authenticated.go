@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	"errors"
 	"time"
 
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/log"
 	"github.com/gofiber/fiber/v3/middleware/session"
@@ -11,10 +13,40 @@ import (
 )
 
 func (m *middleware) Authenticated() fiber.Handler {
+	return m.authenticated(true)
+}
+
+func (m *middleware) Enrolling() fiber.Handler {
+	return m.authenticated(false)
+}
+
+func (m *middleware) authenticated(enforceEnrollment bool) fiber.Handler {
 	return func(ctx fiber.Ctx) error {
+		if token, ok := bearerToken(ctx); ok {
+			return m.tokenAuthenticated(ctx, token, enforceEnrollment)
+		}
+
+		impersonating, err := m.impersonating(ctx)
+
+		if errors.Is(err, ErrImpersonationExpired) {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "Your impersonation session has expired.",
+			})
+		}
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate impersonation: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
 		session := session.FromContext(ctx)
 
-		currentUserId := session.Get("user_id")
+		currentUserId := session.Get(sessions.UserIdKey)
 
 		if currentUserId == nil {
 			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
@@ -41,6 +73,46 @@ func (m *middleware) Authenticated() fiber.Handler {
 			})
 		}
 
+		if !currentUser.Active {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "Your account has been deactivated.",
+			})
+		}
+
+		currentUser.MfaVerified = impersonating || sessions.MfaVerified(ctx)
+
+		if currentUser.MfaEnabled && !currentUser.MfaVerified {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must complete Multi-Factor Authentication to access this resource.",
+			})
+		}
+
+		if enforceEnrollment && !impersonating && !currentUser.MfaEnabled {
+			mfaRequired, err := m.mfaEnrollmentRequired(currentUser)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to evaluate MFA policy: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": err.Error(),
+				})
+			}
+
+			if mfaRequired {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Your organization requires Multi-Factor Authentication. Please enable it to continue.",
+				})
+			}
+		}
+
+		if err := sessions.Touch(ctx, m.storage); err != nil {
+			log.Errorf("🔥 Failed to update session activity: %s", err.Error())
+		}
+
 		session.Session.SetIdleTimeout(1 * time.Hour)
 
 		if err := session.Session.Save(); err != nil {
@@ -58,3 +130,52 @@ func (m *middleware) Authenticated() fiber.Handler {
 		return ctx.Next()
 	}
 }
+
+func (m *middleware) tokenAuthenticated(ctx fiber.Ctx, token string, enforceEnrollment bool) error {
+	currentUser, apiToken, err := m.tokenUser(token)
+
+	if errors.Is(err, ErrInvalidToken) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error":   "Unauthorized",
+			"message": "Invalid or expired access token.",
+		})
+	}
+
+	if err != nil {
+		log.Errorf("🔥 Failed to retrieve API token from database: %s", err.Error())
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Internal Server Error",
+			"message": err.Error(),
+		})
+	}
+
+	if enforceEnrollment && !currentUser.MfaEnabled {
+		mfaRequired, err := m.mfaEnrollmentRequired(currentUser)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate MFA policy: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		if mfaRequired {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "Your organization requires Multi-Factor Authentication. Please enable it to continue.",
+			})
+		}
+	}
+
+	ctx.Locals("user_id", currentUser.Id)
+	ctx.Locals("user", currentUser)
+
+	if apiToken != nil {
+		ctx.Locals("api_token", apiToken)
+	}
+
+	return ctx.Next()
+}
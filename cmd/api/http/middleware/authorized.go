@@ -1,40 +1,80 @@
 package middleware
 
 import (
-	"slices"
-	"strings"
+	"errors"
 
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/log"
 	"github.com/gofiber/fiber/v3/middleware/session"
 	"gorm.io/gorm"
 )
 
-func (m *middleware) Authorized(permissions ...string) fiber.Handler {
+func (m *middleware) Authorized(required ...string) fiber.Handler {
 	return func(ctx fiber.Ctx) error {
-		session := session.FromContext(ctx)
+		var currentUser *models.User
+		var apiToken *models.ApiToken
 
-		currentUserId := session.Get("user_id")
+		if token, ok := bearerToken(ctx); ok {
+			if user, ok := ctx.Locals("user").(*models.User); ok {
+				currentUser = user
+				apiToken, _ = ctx.Locals("api_token").(*models.ApiToken)
+			} else {
+				var err error
 
-		if currentUserId == nil {
-			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error":   "Unauthorized",
-				"message": "You must be logged in to access this resource.",
-			})
-		}
+				currentUser, apiToken, err = m.tokenUser(token)
 
-		var currentUser *models.User
+				if errors.Is(err, ErrInvalidToken) {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "Invalid or expired access token.",
+					})
+				}
+
+				if err != nil {
+					log.Errorf("🔥 Failed to retrieve API token from database: %s", err.Error())
 
-		if err := m.storage.Database().Where("id = ?", currentUserId).Preload("Roles").First(&currentUser).Error; err != nil {
-			if err == gorm.ErrRecordNotFound {
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": err.Error(),
+					})
+				}
+			}
+		} else {
+			session := session.FromContext(ctx)
+
+			currentUserId := session.Get(sessions.UserIdKey)
+
+			if currentUserId == nil {
 				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 					"error":   "Unauthorized",
 					"message": "You must be logged in to access this resource.",
 				})
 			}
 
-			log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+			if err := m.storage.Database().Where("id = ?", currentUserId).Preload("Roles").First(&currentUser).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "You must be logged in to access this resource.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": err.Error(),
+				})
+			}
+		}
+
+		evaluation, err := permissions.Evaluate(m.storage.Database(), currentUser, activeOrganizationId(ctx))
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate permissions: %s", err.Error())
 
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 				"error":   "Internal Server Error",
@@ -42,30 +82,28 @@ func (m *middleware) Authorized(permissions ...string) fiber.Handler {
 			})
 		}
 
-		combinedPermissions := []string{}
-
-		for _, role := range currentUser.Roles {
-			combinedPermissions = append(combinedPermissions, role.Permissions...)
+		if evaluation.OrganizationId != nil {
+			ctx.Locals("organization_id", *evaluation.OrganizationId)
 		}
 
-		if slices.Contains(combinedPermissions, "*") {
-			return ctx.Next()
-		}
+		ctx.Locals("permissions", evaluation.Permissions)
 
-		for _, permission := range combinedPermissions {
-			strippedPermission := strings.TrimSuffix(permission, ".*")
+		if !permissions.Allowed(evaluation.Permissions, required...) {
+			return ctx.Status(fiber.StatusForbidden).
+				JSON(&fiber.Map{
+					"error":   "Forbidden",
+					"message": "You do not have permission to access this resource.",
+				})
+		}
 
-			for _, requiredPermission := range permissions {
-				if strings.HasPrefix(requiredPermission, strippedPermission) {
-					return ctx.Next()
-				}
-			}
+		if apiToken != nil && !permissions.Allowed(apiToken.Scopes, required...) {
+			return ctx.Status(fiber.StatusForbidden).
+				JSON(&fiber.Map{
+					"error":   "Forbidden",
+					"message": "This API token is not scoped to access this resource.",
+				})
 		}
 
-		return ctx.Status(fiber.StatusForbidden).
-			JSON(&fiber.Map{
-				"error":   "Forbidden",
-				"message": "You do not have permission to access this resource.",
-			})
+		return ctx.Next()
 	}
 }
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v3"
+)
+
+func (m *middleware) BodyLimit(limit int) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		if ctx.Request().Header.ContentLength() > limit || len(ctx.Body()) > limit {
+			return ctx.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error":   "Request Entity Too Large",
+				"message": "The request body is too large.",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"gorm.io/gorm"
+)
+
+func (m *middleware) Challenged() fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		impersonating, err := m.impersonating(ctx)
+
+		if errors.Is(err, ErrImpersonationExpired) {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "Your impersonation session has expired.",
+			})
+		}
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate impersonation: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		session := session.FromContext(ctx)
+
+		mfaPending := false
+		currentUserId := session.Get(sessions.UserIdKey)
+
+		if currentUserId == nil {
+			mfaPending = true
+			currentUserId = session.Get(sessions.MfaPendingUserIdKey)
+		}
+
+		if currentUserId == nil {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must be logged in to access this resource.",
+			})
+		}
+
+		var currentUser *models.User
+
+		if err := m.storage.Database().Where("id = ?", currentUserId).Preload("Roles").First(&currentUser).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "You must be logged in to access this resource.",
+				})
+			}
+
+			log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		if !currentUser.Active {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "Your account has been deactivated.",
+			})
+		}
+
+		currentUser.MfaVerified = impersonating || (!mfaPending && sessions.MfaVerified(ctx))
+
+		ctx.Locals("user_id", currentUser.Id)
+		ctx.Locals("user", currentUser)
+		ctx.Locals("mfa_pending", mfaPending)
+
+		return ctx.Next()
+	}
+}
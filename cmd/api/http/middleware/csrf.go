@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"slices"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/extractors"
+	"github.com/gofiber/fiber/v3/middleware/csrf"
+	"github.com/gofiber/fiber/v3/middleware/session"
+)
+
+func (m *middleware) Csrf(store *session.Store, exemptPaths ...string) fiber.Handler {
+	return csrf.New(csrf.Config{
+		Session:        store,
+		CookieName:     common.EnvString("API_CSRF_COOKIE", "one_csrf"),
+		CookieDomain:   common.EnvString("API_COOKIE_DOMAIN", "localhost"),
+		CookiePath:     "/",
+		CookieSameSite: "Lax",
+		CookieSecure:   true,
+		CookieHTTPOnly: false,
+		TrustedOrigins: common.AllowedOrigins(),
+		Extractor:      extractors.FromHeader(csrf.HeaderName),
+		IdleTimeout:    1 * time.Hour,
+		Next: func(ctx fiber.Ctx) bool {
+			if _, ok := bearerToken(ctx); ok {
+				return true
+			}
+
+			return slices.Contains(exemptPaths, ctx.Path())
+		},
+		ErrorHandler: func(ctx fiber.Ctx, err error) error {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "Invalid or missing CSRF token.",
+			})
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+// Grantable only lets the current user hand out the role named by the path
+// parameter if their own permissions, and their API token's scopes, already
+// cover every permission the role grants. Roles owned by an organization can
+// only be granted from inside it. System admins can grant any role.
+func (m *middleware) Grantable(param string) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		currentUser, ok := ctx.Locals("user").(*models.User)
+
+		if !ok {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must be logged in to access this resource.",
+			})
+		}
+
+		var role models.Role
+
+		if err := m.storage.Database().
+			Where("id = ?", ctx.Params(param)).
+			First(&role).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"error":   "Not Found",
+					"message": "The role was not found.",
+				})
+			}
+
+			log.Errorf("🔥 Failed to retrieve role from database: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": "An error occurred while processing your request.",
+			})
+		}
+
+		if apiToken, ok := ctx.Locals("api_token").(*models.ApiToken); ok && !permissions.Grantable(apiToken.Scopes, role.Permissions) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "This API token is not scoped to grant the " + role.Name + " role.",
+			})
+		}
+
+		if currentUser.Type == models.UserTypeSystemAdmin {
+			return ctx.Next()
+		}
+
+		organizationId := activeOrganizationId(ctx)
+
+		if role.OrganizationId != nil && (organizationId == nil || *role.OrganizationId != *organizationId) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "The " + role.Name + " role belongs to another organization.",
+			})
+		}
+
+		evaluation, err := permissions.Evaluate(m.storage.Database(), currentUser, organizationId)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate permissions: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": "An error occurred while processing your request.",
+			})
+		}
+
+		if !permissions.Grantable(evaluation.Permissions, role.Permissions) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "You cannot grant the " + role.Name + " role because your roles do not include all of its permissions.",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
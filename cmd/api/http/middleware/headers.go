@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/helmet"
+)
+
+func (m *middleware) SecurityHeaders(documentationPaths ...string) fiber.Handler {
+	api := helmet.New(helmet.Config{
+		XSSProtection:             "0",
+		ContentTypeNosniff:        "nosniff",
+		XFrameOptions:             "DENY",
+		ContentSecurityPolicy:     common.EnvString("API_CONTENT_SECURITY_POLICY", "default-src 'none'; frame-ancestors 'none'; base-uri 'none'; form-action 'none'"),
+		ReferrerPolicy:            "no-referrer",
+		CrossOriginEmbedderPolicy: "require-corp",
+		CrossOriginOpenerPolicy:   "same-origin",
+		CrossOriginResourcePolicy: "same-site",
+		XDNSPrefetchControl:       "off",
+		XDownloadOptions:          "noopen",
+		XPermittedCrossDomain:     "none",
+		HSTSMaxAge:                common.EnvInt("API_HSTS_MAX_AGE", 31536000),
+	})
+
+	documentation := helmet.New(helmet.Config{
+		XSSProtection:             "0",
+		ContentTypeNosniff:        "nosniff",
+		XFrameOptions:             "DENY",
+		ContentSecurityPolicy:     "default-src 'self'; script-src 'self' 'unsafe-inline' https://cdn.jsdelivr.net; style-src 'self' 'unsafe-inline' https:; font-src 'self' https: data:; img-src 'self' https: data:; connect-src 'self' https:; frame-ancestors 'none'",
+		ReferrerPolicy:            "no-referrer",
+		CrossOriginEmbedderPolicy: "unsafe-none",
+		CrossOriginOpenerPolicy:   "same-origin",
+		CrossOriginResourcePolicy: "same-site",
+		XDNSPrefetchControl:       "off",
+		XDownloadOptions:          "noopen",
+		XPermittedCrossDomain:     "none",
+		HSTSMaxAge:                common.EnvInt("API_HSTS_MAX_AGE", 31536000),
+	})
+
+	return func(ctx fiber.Ctx) error {
+		for _, path := range documentationPaths {
+			if strings.HasPrefix(ctx.Path(), path) {
+				return documentation(ctx)
+			}
+		}
+
+		return api(ctx)
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/impersonation"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/gofiber/fiber/v3"
+)
+
+var ErrImpersonationExpired = errors.New("impersonation has expired")
+
+func (m *middleware) impersonating(ctx fiber.Ctx) (bool, error) {
+	current, ok := impersonation.Current(ctx)
+
+	if !ok {
+		return false, nil
+	}
+
+	if current.Expired() {
+		if _, err := impersonation.Stop(ctx, m.storage, models.ImpersonationActionExpire); err != nil {
+			return false, err
+		}
+
+		return false, ErrImpersonationExpired
+	}
+
+	ctx.Set(impersonation.Header, current.ActorId.String())
+	ctx.Locals("impersonator_id", current.ActorId)
+
+	return true, nil
+}
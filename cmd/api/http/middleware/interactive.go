@@ -0,0 +1,16 @@
+package middleware
+
+import "github.com/gofiber/fiber/v3"
+
+func (m *middleware) Interactive() fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		if ctx.Locals("api_token") != nil {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "API tokens cannot be used to access this resource.",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"gorm.io/gorm"
+)
+
+func (m *middleware) jwtUser(token string) (*models.User, error) {
+	claims, err := jwt.Verify(m.storage, token)
+
+	if errors.Is(err, jwt.ErrInvalidToken) {
+		return nil, ErrInvalidToken
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := jwt.FamilyRevoked(m.storage, claims.FamilyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if revoked {
+		return nil, ErrInvalidToken
+	}
+
+	var currentUser *models.User
+
+	if err := m.storage.Database().
+		Where("id = ?", claims.Subject).
+		Preload("Roles").
+		First(&currentUser).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidToken
+		}
+
+		return nil, err
+	}
+
+	if !currentUser.Active {
+		return nil, ErrInvalidToken
+	}
+
+	currentUser.MfaVerified = claims.MfaVerified
+
+	if currentUser.MfaEnabled && !currentUser.MfaVerified {
+		return nil, ErrInvalidToken
+	}
+
+	return currentUser, nil
+}
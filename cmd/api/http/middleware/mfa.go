@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+)
+
+func (m *middleware) mfaEnrollmentRequired(user *models.User) (bool, error) {
+	if common.EnvString("API_REQUIRE_MFA", "false") == "true" {
+		return true, nil
+	}
+
+	var organizations int64
+
+	if err := m.storage.Database().
+		Model(&models.Organization{}).
+		Where("require_mfa = ?", true).
+		Where(
+			m.storage.Database().
+				Where("owner_id = ?", user.Id).
+				Or("id IN (?)", m.storage.Database().
+					Table("organizations_members").
+					Select("organization_id").
+					Where("user_id = ?", user.Id)),
+		).
+		Count(&organizations).Error; err != nil {
+		return false, err
+	}
+
+	return organizations > 0, nil
+}
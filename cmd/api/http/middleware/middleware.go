@@ -1,14 +1,25 @@
 package middleware
 
 import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
 )
 
 type Middleware interface {
 	Authenticated() fiber.Handler
+	Enrolling() fiber.Handler
+	Challenged() fiber.Handler
 	Authorized(permissions ...string) fiber.Handler
-	// Policies(policies ...models.PolicyType) fiber.Handler
+	Interactive() fiber.Handler
+	Provisioned() fiber.Handler
+	Csrf(store *session.Store, exemptPaths ...string) fiber.Handler
+	SecurityHeaders(documentationPaths ...string) fiber.Handler
+	WebSocketOrigin() fiber.Handler
+	BodyLimit(limit int) fiber.Handler
+	Policies(policies ...models.PolicyType) fiber.Handler
+	Grantable(param string) fiber.Handler
 }
 
 type middleware struct {
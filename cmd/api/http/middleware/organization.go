@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+const OrganizationHeader = "X-Organization-Id"
+
+func activeOrganizationId(ctx fiber.Ctx) *uuid.UUID {
+	candidates := []string{ctx.Params("organizationId")}
+
+	if strings.Contains(ctx.Route().Path, "/organizations/:id") {
+		candidates = append(candidates, ctx.Params("id"))
+	}
+
+	candidates = append(candidates, ctx.Get(OrganizationHeader))
+
+	if value, ok := session.FromContext(ctx).Get(sessions.OrganizationIdKey).(string); ok {
+		candidates = append(candidates, value)
+	}
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+
+		if organizationId, err := uuid.Parse(candidate); err == nil {
+			return &organizationId
+		}
+	}
+
+	return nil
+}
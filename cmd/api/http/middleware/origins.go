@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/gofiber/contrib/v3/websocket"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (m *middleware) WebSocketOrigin() fiber.Handler {
+	origins := common.AllowedOrigins()
+
+	return func(ctx fiber.Ctx) error {
+		if !websocket.IsWebSocketUpgrade(ctx) {
+			return fiber.ErrUpgradeRequired
+		}
+
+		if !slices.Contains(origins, strings.TrimRight(ctx.Get(fiber.HeaderOrigin), "/")) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "WebSocket connections are not allowed from this origin.",
+			})
+		}
+
+		ctx.Locals("ws-allowed", true)
+
+		return ctx.Next()
+	}
+}
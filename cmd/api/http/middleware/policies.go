@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func (m *middleware) Policies(rules ...models.PolicyType) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		currentUser, ok := ctx.Locals("user").(*models.User)
+
+		if !ok || currentUser == nil {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must be logged in to access this resource.",
+			})
+		}
+
+		target, _ := ctx.Locals(policies.TargetKey).(*policies.Target)
+
+		satisfied, err := policies.Evaluate(m.storage.Database(), policies.Request{
+			User:           currentUser,
+			Target:         target,
+			OrganizationId: activeOrganizationId(ctx),
+		}, rules...)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to evaluate policies: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		if !satisfied {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "You do not have access to this resource.",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
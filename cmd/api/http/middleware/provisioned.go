@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+func (m *middleware) Provisioned() fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		token, ok := bearerToken(ctx)
+
+		if !ok {
+			return scimError(ctx, fiber.StatusUnauthorized, "A SCIM bearer token is required.")
+		}
+
+		var scimToken models.ScimToken
+
+		if err := m.storage.Database().
+			Where("token_hash = ?", tokens.Hash(token)).
+			Preload("Organization").
+			First(&scimToken).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return scimError(ctx, fiber.StatusUnauthorized, "Invalid SCIM token.")
+			}
+
+			log.Errorf("🔥 Failed to retrieve SCIM token from database: %s", err.Error())
+
+			return scimError(ctx, fiber.StatusInternalServerError, "An error occurred while processing your request.")
+		}
+
+		if scimToken.LastUsedAt == nil || time.Since(*scimToken.LastUsedAt) > time.Minute {
+			if err := m.storage.Database().
+				Model(&models.ScimToken{}).
+				Where("id = ?", scimToken.Id).
+				Update("last_used_at", time.Now()).Error; err != nil {
+				log.Errorf("🔥 Failed to update SCIM token usage: %s", err.Error())
+			}
+		}
+
+		ctx.Locals("scim_token", &scimToken)
+		ctx.Locals("organization", &scimToken.Organization)
+
+		return ctx.Next()
+	}
+}
+
+func scimError(ctx fiber.Ctx, status int, detail string) error {
+	return ctx.Status(status).JSON(scim.NewError(status, "", detail).Body(), scim.ContentType)
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/gofiber/fiber/v3"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+func bearerToken(ctx fiber.Ctx) (string, bool) {
+	authorization := ctx.Get(fiber.HeaderAuthorization)
+
+	scheme, token, found := strings.Cut(authorization, " ")
+
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+
+	return strings.TrimSpace(token), true
+}
+
+func (m *middleware) tokenUser(token string) (*models.User, *models.ApiToken, error) {
+	if jwt.Looks(token) {
+		currentUser, err := m.jwtUser(token)
+
+		return currentUser, nil, err
+	}
+
+	var apiToken models.ApiToken
+
+	if err := m.storage.Database().
+		Where("token_hash = ? AND expires_at > ?", tokens.Hash(token), time.Now()).
+		First(&apiToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrInvalidToken
+		}
+
+		return nil, nil, err
+	}
+
+	var currentUser *models.User
+
+	if err := m.storage.Database().
+		Where("id = ?", apiToken.UserId).
+		Preload("Roles").
+		First(&currentUser).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrInvalidToken
+		}
+
+		return nil, nil, err
+	}
+
+	if !currentUser.Active {
+		return nil, nil, ErrInvalidToken
+	}
+
+	if apiToken.LastUsedAt == nil || time.Since(*apiToken.LastUsedAt) > time.Minute {
+		now := time.Now()
+
+		if err := m.storage.Database().
+			Model(&models.ApiToken{}).
+			Where("id = ?", apiToken.Id).
+			Update("last_used_at", now).Error; err != nil {
+			return nil, nil, err
+		}
+
+		apiToken.LastUsedAt = &now
+	}
+
+	return currentUser, &apiToken, nil
+}
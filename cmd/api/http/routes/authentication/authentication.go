@@ -3,35 +3,83 @@ package authentication
 import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/bearer"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/impersonation"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/mfa"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/sso"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/tokens"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 )
 
+const bodyLimit = 64 * 1024
+
 type AuthenticationRouter struct {
-	storage    storage.Storage
-	middleware middleware.Middleware
-	mfa        routes.Router
+	storage       storage.Storage
+	middleware    middleware.Middleware
+	mail          mail.Sender
+	mfa           routes.Router
+	sso           routes.Router
+	sessions      routes.Router
+	impersonation routes.Router
+	tokens        routes.Router
+	bearer        routes.Router
 }
 
-func New(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+func New(storage storage.Storage, middleware middleware.Middleware, mail mail.Sender) routes.Router {
 	mfa := mfa.NewMfaRouter(storage, middleware)
+	sso := sso.NewSsoRouter(storage, middleware)
+	tokens := tokens.NewTokensRouter(storage, middleware)
+	sessions := sessions.NewSessionsRouter(storage, middleware)
+	impersonation := impersonation.NewImpersonationRouter(storage, middleware)
+	bearer := bearer.NewBearerRouter(storage, middleware)
 
 	return &AuthenticationRouter{
-		storage:    storage,
-		middleware: middleware,
-		mfa:        mfa,
+		storage:       storage,
+		middleware:    middleware,
+		mail:          mail,
+		mfa:           mfa,
+		sso:           sso,
+		tokens:        tokens,
+		sessions:      sessions,
+		impersonation: impersonation,
+		bearer:        bearer,
 	}
 }
 
 func (r *AuthenticationRouter) LoadRoutes() []routing.Route {
 	routes := []routing.Route{
+		r.LoginRoute(),
+		r.RegisterRoute(),
+		r.VerifyEmailRoute(),
+		r.ResendVerificationRoute(),
+		r.RequestPasswordResetRoute(),
+		r.ConfirmPasswordResetRoute(),
+		r.ChangePasswordRoute(),
+		r.RequestPasswordlessRoute(),
+		r.VerifyPasswordlessLinkRoute(),
+		r.VerifyPasswordlessCodeRoute(),
+		r.CsrfRoute(),
 		r.CheckRoute(),
 		r.MeRoute(),
+		r.SwitchOrganizationRoute(),
 		r.LogoutRoute(),
 	}
 
 	routes = append(routes, r.mfa.LoadRoutes()...)
+	routes = append(routes, r.sso.LoadRoutes()...)
+	routes = append(routes, r.tokens.LoadRoutes()...)
+	routes = append(routes, r.sessions.LoadRoutes()...)
+	routes = append(routes, r.impersonation.LoadRoutes()...)
+	routes = append(routes, r.bearer.LoadRoutes()...)
+
+	for index := range routes {
+		if routes[index].BodyLimit == 0 {
+			routes[index].BodyLimit = bodyLimit
+		}
+	}
 
 	return routes
 }
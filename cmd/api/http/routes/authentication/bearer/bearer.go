@@ -0,0 +1,36 @@
+package bearer
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type BearerRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+type RefreshPayload struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+func NewBearerRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &BearerRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *BearerRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.IssueRoute(),
+		r.PasswordGrantRoute(),
+		r.RefreshRoute(),
+		r.RevokeRoute(),
+		r.JwksRoute(),
+	}
+
+	return routes
+}
@@ -0,0 +1,141 @@
+package bearer
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func (r *BearerRouter) IssueRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("An access token and refresh token have been issued for the current session.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Issue Access Token",
+			Description: "Exchanges the current browser session for a short-lived signed JWT access token and a rotating refresh token, for native clients that cannot hold cookies. The access token is sent as a Bearer token and its signature can be checked against the JWKS endpoint.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: nil,
+			Responses:   responses,
+		},
+		Method: routing.POST,
+		Path:   "/authentication/token",
+		Middlewares: []fiber.Handler{
+			r.middleware.Authenticated(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			currentUser := ctx.Locals("user").(*models.User)
+
+			if ctx.Get(fiber.HeaderAuthorization) != "" {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Access tokens can only be issued from an interactive session.",
+				})
+			}
+
+			if ctx.Locals("impersonator_id") != nil {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Access tokens cannot be issued while impersonating a user.",
+				})
+			}
+
+			pair, err := jwt.IssuePair(r.storage, currentUser, currentUser.MfaVerified, ctx.IP(), ctx.Get(fiber.HeaderUserAgent))
+
+			if err != nil {
+				log.Errorf("🔥 Failed to issue access token: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.Status(fiber.StatusOK).JSON(pair)
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package bearer
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func (r *BearerRouter) JwksRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The public keys used to sign access tokens have been returned.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "JSON Web Key Set",
+			Description: "Returns the public keys that currently sign, or recently signed, access tokens. Keys rotate automatically and are matched to tokens by their kid header.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: nil,
+			Responses:   responses,
+		},
+		Method:      routing.GET,
+		Path:        "/.well-known/jwks.json",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			jwks, err := jwt.PublicKeys(r.storage)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to load signing keys: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			ctx.Set(fiber.HeaderCacheControl, "public, max-age=300")
+
+			return ctx.Status(fiber.StatusOK).JSON(jwks)
+		},
+	}
+}
@@ -0,0 +1,360 @@
+package bearer
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/attempts"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/authenticator"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/recovery"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/secrets"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+type PasswordGrantPayload struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+func (r *BearerRouter) PasswordGrantRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("An access token and refresh token have been issued for the account.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("429", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Too Many Requests").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Issue Access Token With Password",
+			Description: "Exchanges an email and password for a short-lived signed JWT access token and a rotating refresh token, without a browser session. Accounts with MFA enabled must also send a TOTP code or an unused recovery code in `code`.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/PasswordGrantPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/token/password",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload PasswordGrantPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			payload.Email = strings.TrimSpace(payload.Email)
+			payload.Code = strings.TrimSpace(payload.Code)
+
+			if payload.Email == "" || payload.Password == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Please provide an email and password.",
+				})
+			}
+
+			accountKey := attempts.Account(payload.Email)
+			ipKey := attempts.Ip(ctx.IP())
+
+			retryAfter, err := attempts.Check(r.storage, accountKey, ipKey)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to check login attempts: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if retryAfter > 0 {
+				ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+				return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error":   "Too Many Requests",
+					"message": "Too many failed login attempts. Please try again later.",
+				})
+			}
+
+			failure := attempts.Failure{
+				Reason:    "token",
+				IpAddress: ctx.IP(),
+			}
+
+			var existingUser models.User
+
+			if err := r.storage.Database().
+				Where("LOWER(email) = LOWER(?)", payload.Email).
+				First(&existingUser).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					passwords.VerifyDummy(payload.Password)
+
+					if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+						log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+					}
+
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "Invalid email or password.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			failure.UserId = &existingUser.Id
+
+			if existingUser.Password == nil {
+				passwords.VerifyDummy(payload.Password)
+
+				if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "Invalid email or password.",
+				})
+			}
+
+			valid, err := passwords.Verify(payload.Password, existingUser.Password)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to verify password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if !valid {
+				if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "Invalid email or password.",
+				})
+			}
+
+			if err := attempts.Reset(r.storage, accountKey); err != nil {
+				log.Errorf("🔥 Failed to reset login attempts: %s", err.Error())
+			}
+
+			if !existingUser.EmailVerified {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Please verify your email address before logging in.",
+				})
+			}
+
+			if !existingUser.Active {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Your account has been deactivated.",
+				})
+			}
+
+			if existingUser.MfaEnabled {
+				if payload.Code == "" {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "Please provide a Multi-Factor Authentication code or a recovery code.",
+					})
+				}
+
+				mfaKey := attempts.Mfa(existingUser.Id)
+
+				retryAfter, err := attempts.Check(r.storage, mfaKey, ipKey)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to check MFA attempts: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				if retryAfter > 0 {
+					ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+					return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+						"error":   "Too Many Requests",
+						"message": "Too many failed verification attempts. Please try again later.",
+					})
+				}
+
+				verified, err := r.verifyCode(&existingUser, payload.Code)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to verify MFA code: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				if !verified {
+					if err := attempts.Fail(r.storage, attempts.Failure{
+						Reason:    "token_mfa",
+						UserId:    &existingUser.Id,
+						IpAddress: ctx.IP(),
+					}, mfaKey, ipKey); err != nil {
+						log.Errorf("🔥 Failed to record MFA attempt: %s", err.Error())
+					}
+
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "Invalid Multi-Factor Authentication code. Please try again.",
+					})
+				}
+
+				if err := attempts.Reset(r.storage, mfaKey); err != nil {
+					log.Errorf("🔥 Failed to reset MFA attempts: %s", err.Error())
+				}
+			}
+
+			pair, err := jwt.IssuePair(r.storage, &existingUser, existingUser.MfaEnabled, ctx.IP(), ctx.Get(fiber.HeaderUserAgent))
+
+			if err != nil {
+				log.Errorf("🔥 Failed to issue access token: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.Status(fiber.StatusOK).JSON(pair)
+		},
+	}
+}
+
+// verifyCode accepts a TOTP code from a confirmed authenticator, and otherwise
+// falls back to consuming a recovery code.
+func (r *BearerRouter) verifyCode(user *models.User, code string) (bool, error) {
+	config := authenticator.Load().ForUser(user)
+
+	if user.TotpConfirmed && user.MfaSecret != nil && len(code) == config.Digits.Length() {
+		mfaSecret, err := secrets.Decrypt(user.MfaSecret)
+
+		if err != nil {
+			return false, err
+		}
+
+		return config.Validate(r.storage, user.Id, mfaSecret, code)
+	}
+
+	return recovery.Consume(r.storage, user.Id, code)
+}
@@ -0,0 +1,152 @@
+package bearer
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func (r *BearerRouter) RefreshRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("A new access token and refresh token have been issued. The submitted refresh token can no longer be used.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Refresh Access Token",
+			Description: "Exchanges a refresh token for a new access token and refresh token. Each refresh token can only be used once; presenting one that was already used revokes every token issued from the same login.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/RefreshTokenPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/token/refresh",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload RefreshPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || strings.TrimSpace(payload.RefreshToken) == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			pair, err := jwt.Refresh(r.storage, strings.TrimSpace(payload.RefreshToken), ctx.IP(), ctx.Get(fiber.HeaderUserAgent))
+
+			if errors.Is(err, jwt.ErrRefreshTokenReused) {
+				log.Warnf("🚨 Refresh token reuse detected from %s", ctx.IP())
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "This refresh token has already been used. Please log in again.",
+				})
+			}
+
+			if errors.Is(err, jwt.ErrInvalidRefreshToken) {
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "Invalid or expired refresh token.",
+				})
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to refresh access token: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.Status(fiber.StatusOK).JSON(pair)
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package bearer
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func (r *BearerRouter) RevokeRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The refresh token and every token issued alongside it have been revoked.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Revoke Refresh Token",
+			Description: "Logs a native client out by revoking its refresh token. Access tokens issued from the same login stop working immediately.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/RefreshTokenPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/token/revoke",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload RefreshPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || strings.TrimSpace(payload.RefreshToken) == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			if err := jwt.RevokeFamily(r.storage, strings.TrimSpace(payload.RefreshToken)); err != nil && !errors.Is(err, jwt.ErrInvalidRefreshToken) {
+				log.Errorf("🔥 Failed to revoke refresh token: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		},
+	}
+}
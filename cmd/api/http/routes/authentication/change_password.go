@@ -0,0 +1,283 @@
+package authentication
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/attempts"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"gorm.io/gorm"
+)
+
+type ChangePasswordPayload struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+func (r *AuthenticationRouter) ChangePasswordRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The password has been changed and all other sessions have been ended.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("429", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Too Many Requests").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Change Password",
+			Description: "Changes the current user's password after confirming their current password. The new password must satisfy the password policy and must not match a recently used password. Every other session and native client token is ended.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/ChangePasswordPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.POST,
+		Path:   "/authentication/password",
+		Middlewares: []fiber.Handler{
+			r.middleware.Authenticated(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			currentUser := ctx.Locals("user").(*models.User)
+
+			if ctx.Locals("api_token") != nil {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "API tokens cannot be used to change a password.",
+				})
+			}
+
+			if ctx.Locals("impersonator_id") != nil {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "You cannot change a password while impersonating a user.",
+				})
+			}
+
+			var payload ChangePasswordPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || payload.CurrentPassword == "" || payload.NewPassword == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			if currentUser.Password == nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Your account does not have a password. Please use password reset to set one.",
+				})
+			}
+
+			accountKey := attempts.Account(currentUser.Email)
+
+			retryAfter, err := attempts.Check(r.storage, accountKey)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to check login attempts: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if retryAfter > 0 {
+				ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+				return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error":   "Too Many Requests",
+					"message": "Too many failed attempts. Please try again later.",
+				})
+			}
+
+			valid, err := passwords.Verify(payload.CurrentPassword, currentUser.Password)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to verify password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if !valid {
+				failure := attempts.Failure{
+					UserId:    &currentUser.Id,
+					Reason:    "change_password",
+					IpAddress: ctx.IP(),
+				}
+
+				if err := attempts.Fail(r.storage, failure, accountKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Your current password is incorrect.",
+				})
+			}
+
+			if err := attempts.Reset(r.storage, accountKey); err != nil {
+				log.Errorf("🔥 Failed to reset login attempts: %s", err.Error())
+			}
+
+			password, err := r.newPassword(currentUser, payload.NewPassword)
+
+			var policyErr *passwords.PolicyError
+
+			if errors.As(err, &policyErr) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": policyErr.Message,
+				})
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to set password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				if err := tx.Model(&models.User{}).
+					Where("id = ?", currentUser.Id).
+					Update("password", password).Error; err != nil {
+					return err
+				}
+
+				return passwords.Remember(tx, currentUser.Id, password)
+			}); err != nil {
+				log.Errorf("🔥 Failed to change password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if err := sessions.RevokeAll(r.storage, currentUser.Id, session.FromContext(ctx).ID()); err != nil {
+				log.Errorf("🔥 Failed to revoke sessions: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Your password was changed but other sessions could not be ended.",
+				})
+			}
+
+			if err := jwt.RevokeUser(r.storage, currentUser.Id); err != nil {
+				log.Errorf("🔥 Failed to revoke refresh tokens: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Your password was changed but other sessions could not be ended.",
+				})
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		},
+	}
+}
@@ -105,6 +105,7 @@ func (r *AuthenticationRouter) CheckRoute() routing.Route {
 		Middlewares: []fiber.Handler{
 			r.middleware.Authenticated(),
 		},
+		ApiTokens: true,
 		Handler: func(ctx fiber.Ctx) error {
 			user, ok := ctx.Locals("user").(*models.User)
 
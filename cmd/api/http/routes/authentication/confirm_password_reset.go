@@ -0,0 +1,235 @@
+package authentication
+
+import (
+	"errors"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+type ConfirmPasswordResetPayload struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+func (r *AuthenticationRouter) ConfirmPasswordResetRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The password has been reset and all existing sessions have been ended.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Confirm Password Reset",
+			Description: "Sets a new password using a password reset token and ends all of the user's existing sessions.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/ConfirmPasswordResetPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/password/reset/confirm",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload ConfirmPasswordResetPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || payload.Token == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			var reset models.PasswordReset
+
+			if err := r.storage.Database().
+				Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", tokens.Hash(payload.Token), time.Now()).
+				Preload("User").
+				First(&reset).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The password reset link is invalid or has expired.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve password reset: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			password, err := r.newPassword(&reset.User, payload.Password)
+
+			var policyErr *passwords.PolicyError
+
+			if errors.As(err, &policyErr) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": policyErr.Message,
+				})
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to set password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			err = r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				result := tx.Model(&models.PasswordReset{}).
+					Where("id = ? AND used_at IS NULL", reset.Id).
+					Update("used_at", time.Now())
+
+				if result.Error != nil {
+					return result.Error
+				}
+
+				if result.RowsAffected == 0 {
+					return gorm.ErrRecordNotFound
+				}
+
+				if err := tx.Model(&models.User{}).
+					Where("id = ?", reset.UserId).
+					Updates(map[string]any{
+						"password":       password,
+						"email_verified": true,
+					}).Error; err != nil {
+					return err
+				}
+
+				return passwords.Remember(tx, reset.UserId, password)
+			})
+
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The password reset link is invalid or has expired.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to reset password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if err := sessions.RevokeAll(r.storage, reset.UserId); err != nil {
+				log.Errorf("🔥 Failed to revoke sessions: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Your password was reset but existing sessions could not be ended.",
+				})
+			}
+
+			if err := jwt.RevokeUser(r.storage, reset.UserId); err != nil {
+				log.Errorf("🔥 Failed to revoke refresh tokens: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Your password was reset but existing sessions could not be ended.",
+				})
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+)
+
+func (r *AuthenticationRouter) sendEmailVerification(user *models.User) error {
+	token, tokenHash, err := tokens.Generate()
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.storage.Database().
+		Where("user_id = ? AND used_at IS NULL", user.Id).
+		Delete(&models.EmailVerification{}).Error; err != nil {
+		return err
+	}
+
+	verification := models.EmailVerification{
+		UserId:    user.Id,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(common.EnvDuration("API_EMAIL_VERIFICATION_TTL", 24*time.Hour)),
+	}
+
+	if err := r.storage.Database().Create(&verification).Error; err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf(
+		"%s/verify-email?token=%s",
+		common.EnvString("APP_BASE_URL", "http://localhost:3000"),
+		url.QueryEscape(token),
+	)
+
+	return r.mail.Send(mail.Message{
+		To:      []string{user.Email},
+		Subject: "Verify your email address",
+		Text: fmt.Sprintf(
+			"Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThis link expires at %s. If you did not create an account you can ignore this email.",
+			user.Name,
+			link,
+			verification.ExpiresAt.Format(time.RFC1123),
+		),
+	})
+}
+
+func (r *AuthenticationRouter) sendAccountExists(user *models.User) error {
+	link := fmt.Sprintf(
+		"%s/reset-password",
+		common.EnvString("APP_BASE_URL", "http://localhost:3000"),
+	)
+
+	return r.mail.Send(mail.Message{
+		To:      []string{user.Email},
+		Subject: "You already have an account",
+		Text: fmt.Sprintf(
+			"Hi %s,\n\nSomeone tried to create an account with this email address, but you already have one. You can log in as usual, or reset your password here if you have forgotten it:\n\n%s\n\nIf this was not you, you can ignore this email.",
+			user.Name,
+			link,
+		),
+	})
+}
+
+func (r *AuthenticationRouter) sendPasswordReset(user *models.User) error {
+	token, tokenHash, err := tokens.Generate()
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.storage.Database().
+		Where("user_id = ? AND used_at IS NULL", user.Id).
+		Delete(&models.PasswordReset{}).Error; err != nil {
+		return err
+	}
+
+	reset := models.PasswordReset{
+		UserId:    user.Id,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(common.EnvDuration("API_PASSWORD_RESET_TTL", 1*time.Hour)),
+	}
+
+	if err := r.storage.Database().Create(&reset).Error; err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf(
+		"%s/reset-password?token=%s",
+		common.EnvString("APP_BASE_URL", "http://localhost:3000"),
+		url.QueryEscape(token),
+	)
+
+	return r.mail.Send(mail.Message{
+		To:      []string{user.Email},
+		Subject: "Reset your password",
+		Text: fmt.Sprintf(
+			"Hi %s,\n\nA password reset was requested for your account. Open the link below to choose a new password:\n\n%s\n\nThis link expires at %s and can only be used once. If you did not request a reset you can ignore this email.",
+			user.Name,
+			link,
+			reset.ExpiresAt.Format(time.RFC1123),
+		),
+	})
+}
+
+// ErrTooManyLoginCodes is returned when an account already has the most
+// pending sign-in codes allowed, so that requests cannot flood its inbox.
+var ErrTooManyLoginCodes = errors.New("too many pending login codes")
+
+func (r *AuthenticationRouter) sendLoginCode(user *models.User, browserHash []byte) error {
+	token, tokenHash, err := tokens.Generate()
+
+	if err != nil {
+		return err
+	}
+
+	code, err := tokens.Code(6)
+
+	if err != nil {
+		return err
+	}
+
+	// Only this browser's earlier code is replaced, so a request from another
+	// browser cannot cancel a sign-in that is already in progress.
+	if err := r.storage.Database().
+		Where("user_id = ? AND browser_hash = ? AND used_at IS NULL", user.Id, browserHash).
+		Delete(&models.LoginCode{}).Error; err != nil {
+		return err
+	}
+
+	var pending int64
+
+	if err := r.storage.Database().
+		Model(&models.LoginCode{}).
+		Where("user_id = ? AND used_at IS NULL AND expires_at > ?", user.Id, time.Now()).
+		Count(&pending).Error; err != nil {
+		return err
+	}
+
+	if pending >= int64(common.EnvInt("API_PASSWORDLESS_MAX_PENDING", 3)) {
+		return ErrTooManyLoginCodes
+	}
+
+	loginCode := models.LoginCode{
+		UserId:      user.Id,
+		TokenHash:   tokenHash,
+		CodeHash:    tokens.Hash(code),
+		BrowserHash: browserHash,
+		ExpiresAt:   time.Now().Add(common.EnvDuration("API_PASSWORDLESS_TTL", 10*time.Minute)),
+	}
+
+	if err := r.storage.Database().Create(&loginCode).Error; err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf(
+		"%s/passwordless?token=%s",
+		common.EnvString("APP_BASE_URL", "http://localhost:3000"),
+		url.QueryEscape(token),
+	)
+
+	return r.mail.Send(mail.Message{
+		To:      []string{user.Email},
+		Subject: "Your sign-in link",
+		Text: fmt.Sprintf(
+			"Hi %s,\n\nOpen the link below to sign in:\n\n%s\n\nOr enter this code: %s\n\nThe link and code only work in the browser where you requested them, can only be used once and expire at %s. If you did not try to sign in you can ignore this email.",
+			user.Name,
+			link,
+			code,
+			loginCode.ExpiresAt.Format(time.RFC1123),
+		),
+	})
+}
@@ -0,0 +1,30 @@
+package impersonation
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type ImpersonationRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func NewImpersonationRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &ImpersonationRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *ImpersonationRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.StatusRoute(),
+		r.StartRoute(),
+		r.StopRoute(),
+	}
+
+	return routes
+}
@@ -0,0 +1,239 @@
+package impersonation
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/impersonation"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/google/uuid"
+)
+
+type StartPayload struct {
+	Reason string `json:"reason"`
+}
+
+func (r *ImpersonationRouter) StartRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The session is now impersonating the user.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Start Impersonation",
+			Description: "Acts as another user for a limited time so support staff can reproduce problems exactly as the user sees them. Every start and stop is audited. Unless the caller is a system administrator, the user must belong to the caller's active organization, and no one can impersonate a user with a higher user type than their own.",
+			Tags:        []string{"Authentication"},
+			Parameters: []*openapi3.ParameterRef{
+				{
+					Ref: "#/components/parameters/Id",
+				},
+			},
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/StartImpersonationPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.POST,
+		Path:   "/authentication/impersonation/{id}",
+		Middlewares: []fiber.Handler{
+			r.middleware.Authenticated(),
+		},
+		Permissions: []string{"users.impersonate"},
+		Handler: func(ctx fiber.Ctx) error {
+			currentUser := ctx.Locals("user").(*models.User)
+
+			if ctx.Locals("api_token") != nil {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "API tokens cannot be used to impersonate users.",
+				})
+			}
+
+			if ctx.Locals("impersonator_id") != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Please stop the current impersonation first.",
+				})
+			}
+
+			var payload StartPayload
+
+			if len(ctx.Body()) > 0 {
+				if err := ctx.Bind().Body(&payload); err != nil {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "Invalid request body.",
+					})
+				}
+			}
+
+			var target models.User
+
+			if err := r.storage.Database().
+				Where("id = ?", ctx.Params("id")).
+				First(&target).Error; err != nil {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"error":   "Not Found",
+					"message": "User not found.",
+				})
+			}
+
+			if !target.Active {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Deactivated users cannot be impersonated.",
+				})
+			}
+
+			if target.Id == currentUser.Id {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "You cannot impersonate yourself.",
+				})
+			}
+
+			if target.Type.Outranks(currentUser.Type) {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "You cannot impersonate a user with a higher user type than your own.",
+				})
+			}
+
+			if currentUser.Type != models.UserTypeSystemAdmin {
+				organizationId, ok := ctx.Locals("organization_id").(uuid.UUID)
+
+				if !ok {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "Please select an organization before impersonating its users.",
+					})
+				}
+
+				member, err := permissions.Member(r.storage.Database(), target.Id, organizationId)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to check the user's organization: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				if !member {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "You can only impersonate users in your active organization.",
+					})
+				}
+			}
+
+			started, err := impersonation.Start(
+				ctx,
+				r.storage,
+				currentUser.Id,
+				target.Id,
+				strings.TrimSpace(payload.Reason),
+			)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to start impersonation: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			ctx.Set(impersonation.Header, currentUser.Id.String())
+
+			return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+				"item":          target,
+				"impersonation": started,
+			})
+		},
+	}
+}
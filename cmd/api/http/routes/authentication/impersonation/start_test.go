@@ -0,0 +1,87 @@
+package impersonation
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+func TestStartRestrictions(t *testing.T) {
+	targetId := uuid.New()
+	organizationId := uuid.New()
+
+	tests := []struct {
+		name         string
+		callerType   models.UserType
+		targetType   models.UserType
+		organization bool
+		member       bool
+		status       int
+	}{
+		{"user in the active organization", models.UserTypeOrganizationOwner, models.UserTypeOrganizationUser, true, true, fiber.StatusOK},
+		{"same user type", models.UserTypeOrganizationUser, models.UserTypeOrganizationUser, true, true, fiber.StatusOK},
+		{"user outside the active organization", models.UserTypeOrganizationOwner, models.UserTypeOrganizationUser, true, false, fiber.StatusForbidden},
+		{"no active organization", models.UserTypeSystemUser, models.UserTypeOrganizationUser, false, true, fiber.StatusForbidden},
+		{"higher user type", models.UserTypeOrganizationUser, models.UserTypeOrganizationOwner, true, true, fiber.StatusForbidden},
+		{"system user impersonates a system admin", models.UserTypeSystemUser, models.UserTypeSystemAdmin, true, true, fiber.StatusForbidden},
+		{"system admin outside any organization", models.UserTypeSystemAdmin, models.UserTypeSystemUser, false, false, fiber.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := &testutil.Database{
+				Query: func(statement testutil.Statement) *testutil.Rows {
+					switch {
+					case statement.Contains("count(*)"):
+						if test.member {
+							return testutil.Count(1)
+						}
+
+						return testutil.Count(0)
+					case statement.Contains(`FROM "users"`):
+						return testutil.Row(map[string]any{
+							"id":     targetId.String(),
+							"type":   string(test.targetType),
+							"active": true,
+						})
+					}
+
+					return nil
+				},
+			}
+
+			storage := testutil.Fake(t, database)
+			router := &ImpersonationRouter{storage: storage, middleware: middleware.New(storage)}
+			route := router.StartRoute()
+
+			app := fiber.New()
+			app.Use(session.New())
+
+			app.Post("/authentication/impersonation/:id", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", &models.User{Base: models.Base{Id: uuid.New()}, Type: test.callerType})
+
+				if test.organization {
+					ctx.Locals("organization_id", organizationId)
+				}
+
+				return ctx.Next()
+			}, route.Handler)
+
+			response, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/authentication/impersonation/"+targetId.String(), nil))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package authentication_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestImpersonationRestrictions(t *testing.T) {
+	storage := testutil.DryRun(t)
+	router := authentication.New(storage, middleware.New(storage), nil)
+
+	restricted := map[string]string{
+		"POST /authentication/token":                        "/authentication/token",
+		"POST /authentication/password":                     "/authentication/password",
+		"POST /authentication/tokens":                       "/authentication/tokens",
+		"POST /authentication/mfa/recovery/regenerate":      "/authentication/mfa/recovery/regenerate",
+		"GET /authentication/mfa/totp/enable":               "/authentication/mfa/totp/enable",
+		"POST /authentication/mfa/webauthn/register/begin":  "/authentication/mfa/webauthn/register/begin",
+		"POST /authentication/mfa/webauthn/register/finish": "/authentication/mfa/webauthn/register/finish",
+		"DELETE /authentication/sessions/{id}":              "/authentication/sessions/" + uuid.NewString(),
+		"DELETE /authentication/sessions":                   "/authentication/sessions",
+	}
+
+	found := map[string]bool{}
+
+	for _, route := range router.LoadRoutes() {
+		key := string(route.Method) + " " + route.Path
+		target, ok := restricted[key]
+
+		if !ok {
+			continue
+		}
+
+		found[key] = true
+
+		t.Run(key, func(t *testing.T) {
+			app := fiber.New()
+
+			app.Use(func(ctx fiber.Ctx) error {
+				ctx.Locals("user", &models.User{
+					Base:          models.Base{Id: uuid.New()},
+					Type:          models.UserTypeOrganizationUser,
+					MfaEnabled:    true,
+					EmailVerified: true,
+					Active:        true,
+				})
+				ctx.Locals("impersonator_id", uuid.New())
+
+				return ctx.Next()
+			})
+			app.Add([]string{string(route.Method)}, strings.NewReplacer("{", ":", "}", "").Replace(route.Path), route.Handler)
+
+			response, err := app.Test(httptest.NewRequest(string(route.Method), target, strings.NewReader("{}")))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != fiber.StatusForbidden {
+				t.Errorf("status = %d, want %d", response.StatusCode, fiber.StatusForbidden)
+			}
+		})
+	}
+
+	for key := range restricted {
+		if !found[key] {
+			t.Errorf("route %s is not registered", key)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package authentication
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/attempts"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+type LoginPayload struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (r *AuthenticationRouter) LoginRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The user is logged in, or a Multi-Factor Authentication challenge is required.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("429", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Too Many Requests").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Login User",
+			Description: "Logs in a user with their email and password. When Multi-Factor Authentication is enabled a challenge is returned instead of a full session.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/LoginPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/login",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload LoginPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			payload.Email = strings.TrimSpace(payload.Email)
+
+			if payload.Email == "" || payload.Password == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Please provide an email and password.",
+				})
+			}
+
+			accountKey := attempts.Account(payload.Email)
+			ipKey := attempts.Ip(ctx.IP())
+
+			retryAfter, err := attempts.Check(r.storage, accountKey, ipKey)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to check login attempts: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if retryAfter > 0 {
+				ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+				return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error":   "Too Many Requests",
+					"message": "Too many failed login attempts. Please try again later.",
+				})
+			}
+
+			failure := attempts.Failure{
+				Reason:    "login",
+				IpAddress: ctx.IP(),
+			}
+
+			var existingUser models.User
+
+			if err := r.storage.Database().
+				Where("LOWER(email) = LOWER(?)", payload.Email).
+				First(&existingUser).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					passwords.VerifyDummy(payload.Password)
+
+					if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+						log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+					}
+
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "Invalid email or password.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			failure.UserId = &existingUser.Id
+
+			if existingUser.Password == nil {
+				passwords.VerifyDummy(payload.Password)
+
+				if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "Invalid email or password.",
+				})
+			}
+
+			valid, err := passwords.Verify(payload.Password, existingUser.Password)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to verify password: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if !valid {
+				if err := attempts.Fail(r.storage, failure, accountKey, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "Invalid email or password.",
+				})
+			}
+
+			if err := attempts.Reset(r.storage, accountKey); err != nil {
+				log.Errorf("🔥 Failed to reset login attempts: %s", err.Error())
+			}
+
+			if passwords.NeedsRehash(existingUser.Password) {
+				if err := r.rehashPassword(&existingUser, payload.Password); err != nil {
+					log.Errorf("🔥 Failed to upgrade password hash: %s", err.Error())
+				}
+			}
+
+			if !existingUser.EmailVerified {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Please verify your email address before logging in.",
+				})
+			}
+
+			return r.startSession(ctx, &existingUser)
+		},
+	}
+}
@@ -2,8 +2,10 @@ package authentication
 
 import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
 	"github.com/gofiber/fiber/v3/middleware/session"
 )
 
@@ -103,11 +105,21 @@ func (r *AuthenticationRouter) LogoutRoute() routing.Route {
 		Method: routing.POST,
 		Path:   "/authentication/logout",
 		Middlewares: []fiber.Handler{
-			r.middleware.Authenticated(),
+			r.middleware.Challenged(),
 		},
 		Handler: func(ctx fiber.Ctx) error {
 			session := session.FromContext(ctx)
 
+			if err := sessions.Revoke(r.storage, session.ID()); err != nil {
+				log.Errorf("🔥 Failed to revoke session: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).
+					JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "Failed to log out.",
+					})
+			}
+
 			if err := session.Destroy(); err != nil {
 				return ctx.Status(fiber.StatusInternalServerError).
 					JSON(fiber.Map{
@@ -103,7 +103,7 @@ func (r *AuthenticationRouter) MeRoute() routing.Route {
 		Method: routing.GET,
 		Path:   "/authentication/me",
 		Middlewares: []fiber.Handler{
-			r.middleware.Authenticated(),
+			r.middleware.Challenged(),
 		},
 		Handler: func(ctx fiber.Ctx) error {
 			user, ok := ctx.Locals("user").(*models.User)
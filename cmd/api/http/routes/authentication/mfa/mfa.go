@@ -3,7 +3,9 @@ package mfa
 import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/mfa/recovery"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/mfa/totp"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes/authentication/mfa/webauthn"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 )
@@ -12,15 +14,21 @@ type MfaRouter struct {
 	storage    storage.Storage
 	middleware middleware.Middleware
 	totp       routes.Router
+	recovery   routes.Router
+	webauthn   routes.Router
 }
 
 func NewMfaRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
 	totp := totp.NewTotpRouter(storage, middleware)
+	recovery := recovery.NewRecoveryRouter(storage, middleware)
+	webauthn := webauthn.NewWebauthnRouter(storage, middleware)
 
 	return &MfaRouter{
 		storage:    storage,
 		middleware: middleware,
 		totp:       totp,
+		recovery:   recovery,
+		webauthn:   webauthn,
 	}
 }
 
@@ -28,6 +36,8 @@ func (r *MfaRouter) LoadRoutes() []routing.Route {
 	routes := []routing.Route{}
 
 	routes = append(routes, r.totp.LoadRoutes()...)
+	routes = append(routes, r.recovery.LoadRoutes()...)
+	routes = append(routes, r.webauthn.LoadRoutes()...)
 
 	return routes
 }
@@ -0,0 +1,30 @@
+package recovery
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type RecoveryRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func NewRecoveryRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &RecoveryRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *RecoveryRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.StatusRoute(),
+		r.VerifyRoute(),
+		r.RegenerateRoute(),
+	}
+
+	return routes
+}
@@ -2,16 +2,15 @@ package totp
 
 import (
 	"bytes"
-	"encoding/base32"
 	"image/png"
 
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/authenticator"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/secrets"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/log"
-	"github.com/pquerna/otp"
-	"github.com/pquerna/otp/totp"
 )
 
 func (r *TotpRouter) EnableRoute() routing.Route {
@@ -19,7 +18,7 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 
 	responses.Set("200", &openapi3.ResponseRef{
 		Value: openapi3.NewResponse().
-			WithDescription("The MFA QR code has been generated and returned.").
+			WithDescription("The MFA QR code has been generated and returned. Send Accept: application/json to receive the otpauth URI and manual-entry secret instead.").
 			WithContent(openapi3.Content{
 				"text/plain": openapi3.NewMediaType().
 					WithSchemaRef(&openapi3.SchemaRef{
@@ -84,6 +83,20 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 			}),
 	})
 
+	responses.Set("409", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Conflict").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
 	responses.Set("500", &openapi3.ResponseRef{
 		Value: openapi3.NewResponse().
 			WithJSONSchemaRef(&openapi3.SchemaRef{
@@ -101,7 +114,7 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 	return routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary:     "Enable TOTP MFA",
-			Description: "Enables Time-based One-Time Password (TOTP) Multi-Factor Authentication for the user.",
+			Description: "Starts Time-based One-Time Password (TOTP) Multi-Factor Authentication enrollment for the user. The secret is only returned until the first code is verified.",
 			Tags:        []string{"Authentication"},
 			Parameters:  nil,
 			RequestBody: nil,
@@ -110,7 +123,7 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 		Method: routing.GET,
 		Path:   "/authentication/mfa/totp/enable",
 		Middlewares: []fiber.Handler{
-			r.middleware.Authenticated(),
+			r.middleware.Enrolling(),
 		},
 		Handler: func(c fiber.Ctx) error {
 			currentUser := c.Locals("user").(*models.User)
@@ -122,30 +135,54 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 				})
 			}
 
-			if currentUser.MfaSecret == nil {
-				secret, err := totp.Generate(totp.GenerateOpts{
-					Issuer:      "One TOTP MFA",
-					AccountName: currentUser.Email,
-					Period:      30,
-					Digits:      otp.DigitsSix,
-					Algorithm:   otp.AlgorithmSHA1,
-					SecretSize:  32,
+			if c.Locals("impersonator_id") != nil {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "TOTP cannot be enrolled while impersonating a user.",
 				})
+			}
+
+			if currentUser.TotpConfirmed {
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+					"error":   "Conflict",
+					"message": "TOTP is already enabled. Reset it before enrolling again.",
+				})
+			}
+
+			config := authenticator.Load()
+
+			if currentUser.MfaSecret == nil {
+				secret, err := config.Generate(currentUser.Email)
 
 				if err != nil {
 					return c.SendStatus(fiber.StatusInternalServerError)
 				}
 
-				currentUser.MfaSecret = []byte(secret.Secret())
+				encryptedSecret, err := secrets.Encrypt([]byte(secret.Secret()))
+
+				if err != nil {
+					log.Errorf("🔥 Failed to encrypt TOTP secret: %s", err.Error())
+
+					return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				currentUser.MfaSecret = encryptedSecret
+				currentUser.TotpPeriod = config.Period
+				currentUser.TotpDigits = config.Digits.Length()
+				currentUser.TotpAlgorithm = config.Algorithm.String()
+
+				columns := config.Columns()
+				columns["mfa_secret"] = currentUser.MfaSecret
+				columns["mfa_last_step"] = 0
+				columns["totp_confirmed"] = false
 
 				if err := r.storage.Database().
 					Where("id = ?", currentUser.Id).
 					Model(&currentUser).
-					Updates(map[string]any{
-						"mfa_secret":   currentUser.MfaSecret,
-						"mfa_enabled":  false,
-						"mfa_verified": false,
-					}).Error; err != nil {
+					Updates(columns).Error; err != nil {
 					log.Infof("🔥 Failed to update user: %s", err.Error())
 
 					return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -155,22 +192,15 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 				}
 			}
 
-			secretBytes, err := base32.StdEncoding.WithPadding(base32.NoPadding).
-				DecodeString(string(currentUser.MfaSecret))
+			mfaSecret, err := secrets.Decrypt(currentUser.MfaSecret)
 
 			if err != nil {
+				log.Errorf("🔥 Failed to decrypt TOTP secret: %s", err.Error())
+
 				return c.SendStatus(fiber.StatusInternalServerError)
 			}
 
-			secret, err := totp.Generate(totp.GenerateOpts{
-				Issuer:      "One TOTP MFA",
-				AccountName: currentUser.Email,
-				Period:      30,
-				Digits:      otp.DigitsSix,
-				Algorithm:   otp.AlgorithmSHA1,
-				Secret:      secretBytes,
-				SecretSize:  32,
-			})
+			secret, err := config.ForUser(currentUser).Key(currentUser.Email, mfaSecret)
 
 			if err != nil {
 				log.Infof("🔥 Failed to generate TOTP secret: %s", err.Error())
@@ -178,6 +208,18 @@ func (r *TotpRouter) EnableRoute() routing.Route {
 				return c.SendStatus(fiber.StatusInternalServerError)
 			}
 
+			if c.Accepts("image/png", fiber.MIMEApplicationJSON) == fiber.MIMEApplicationJSON {
+				return c.Status(fiber.StatusOK).JSON(fiber.Map{
+					"uri":         secret.URL(),
+					"secret":      secret.Secret(),
+					"issuer":      secret.Issuer(),
+					"accountName": secret.AccountName(),
+					"algorithm":   secret.Algorithm().String(),
+					"digits":      secret.Digits().Length(),
+					"period":      secret.Period(),
+				})
+			}
+
 			var pngBuffer bytes.Buffer
 
 			image, err := secret.Image(256, 256)
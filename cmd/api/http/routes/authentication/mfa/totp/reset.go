@@ -2,6 +2,7 @@ package totp
 
 import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/recovery"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v3"
@@ -110,6 +111,7 @@ func (r *TotpRouter) ResetRoute() routing.Route {
 		Middlewares: []fiber.Handler{
 			r.middleware.Authenticated(),
 		},
+		Permissions: []string{"users.mfa.reset"},
 		Handler: func(ctx fiber.Ctx) error {
 			userId := ctx.Params("id")
 
@@ -128,14 +130,18 @@ func (r *TotpRouter) ResetRoute() routing.Route {
 
 			currentUser.MfaSecret = nil
 			currentUser.MfaEnabled = false
-			currentUser.MfaVerified = false
 
 			if err := r.storage.Database().
 				Where("id = ?", currentUser.Id).
 				Model(&currentUser).
 				Updates(map[string]any{
-					"mfa_enabled":  currentUser.MfaEnabled,
-					"mfa_verified": currentUser.MfaVerified,
+					"mfa_secret":     currentUser.MfaSecret,
+					"mfa_enabled":    currentUser.MfaEnabled,
+					"mfa_last_step":  0,
+					"totp_confirmed": false,
+					"totp_period":    0,
+					"totp_digits":    0,
+					"totp_algorithm": nil,
 				}).Error; err != nil {
 				log.Errorf("🔥 Error updating user: %s", err.Error())
 
@@ -145,6 +151,26 @@ func (r *TotpRouter) ResetRoute() routing.Route {
 				})
 			}
 
+			if err := recovery.Clear(r.storage, currentUser.Id); err != nil {
+				log.Errorf("🔥 Error clearing recovery codes: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if err := r.storage.Database().
+				Where("user_id = ?", currentUser.Id).
+				Delete(&models.WebauthnCredential{}).Error; err != nil {
+				log.Errorf("🔥 Error deleting WebAuthn credentials: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
 			return ctx.SendStatus(fiber.StatusOK)
 		},
 	}
@@ -1,12 +1,16 @@
 package totp
 
 import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/attempts"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/authenticator"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/recovery"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/secrets"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/log"
-	"github.com/pquerna/otp/totp"
 )
 
 func (r *TotpRouter) VerifyRoute() routing.Route {
@@ -79,6 +83,20 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 			}),
 	})
 
+	responses.Set("429", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Too Many Requests").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
 	responses.Set("500", &openapi3.ResponseRef{
 		Value: openapi3.NewResponse().
 			WithJSONSchemaRef(&openapi3.SchemaRef{
@@ -109,7 +127,7 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 		Method: routing.POST,
 		Path:   "/authentication/mfa/totp/verify",
 		Middlewares: []fiber.Handler{
-			r.middleware.Authenticated(),
+			r.middleware.Challenged(),
 		},
 		Handler: func(ctx fiber.Ctx) error {
 			currentUser := ctx.Locals("user").(*models.User)
@@ -127,7 +145,9 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 				})
 			}
 
-			if queryParams.Code == "" || len(queryParams.Code) < 6 || len(queryParams.Code) > 6 {
+			config := authenticator.Load().ForUser(currentUser)
+
+			if queryParams.Code == "" || len(queryParams.Code) != config.Digits.Length() {
 				log.Warn("🚫 Unauthorized access attempt: No MFA code provided")
 
 				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -145,7 +165,60 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 				})
 			}
 
-			if !totp.Validate(queryParams.Code, string(currentUser.MfaSecret)) {
+			mfaKey := attempts.Mfa(currentUser.Id)
+			ipKey := attempts.Ip(ctx.IP())
+
+			retryAfter, err := attempts.Check(r.storage, mfaKey, ipKey)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to check MFA attempts: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if retryAfter > 0 {
+				ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+				return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error":   "Too Many Requests",
+					"message": "Too many failed verification attempts. Please try again later.",
+				})
+			}
+
+			mfaSecret, err := secrets.Decrypt(currentUser.MfaSecret)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to decrypt TOTP secret: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			valid, err := config.Validate(r.storage, currentUser.Id, mfaSecret, queryParams.Code)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to validate TOTP code: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if !valid {
+				if err := attempts.Fail(r.storage, attempts.Failure{
+					Reason:    "totp",
+					UserId:    &currentUser.Id,
+					IpAddress: ctx.IP(),
+				}, mfaKey, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record MFA attempt: %s", err.Error())
+				}
+
 				return ctx.Status(fiber.StatusUnauthorized).
 					JSON(fiber.Map{
 						"error":   "Unauthorized",
@@ -153,15 +226,21 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 					})
 			}
 
+			if err := attempts.Reset(r.storage, mfaKey); err != nil {
+				log.Errorf("🔥 Failed to reset MFA attempts: %s", err.Error())
+			}
+
+			enrolling := !currentUser.MfaEnabled
+
 			currentUser.MfaEnabled = true
-			currentUser.MfaVerified = true
+			currentUser.TotpConfirmed = true
 
 			if err := r.storage.Database().
 				Where("id = ?", currentUser.Id).
 				Model(&currentUser).
 				Updates(map[string]any{
-					"mfa_enabled":  currentUser.MfaEnabled,
-					"mfa_verified": currentUser.MfaVerified,
+					"mfa_enabled":    currentUser.MfaEnabled,
+					"totp_confirmed": currentUser.TotpConfirmed,
 				}).Error; err != nil {
 				log.Errorf("🔥 Error updating user: %s", err.Error())
 
@@ -171,6 +250,32 @@ func (r *TotpRouter) VerifyRoute() routing.Route {
 				})
 			}
 
+			if err := sessions.CompleteMfa(ctx, r.storage, currentUser.Id); err != nil {
+				log.Errorf("🔥 Failed to start session: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Failed to save session.",
+				})
+			}
+
+			if enrolling {
+				codes, err := recovery.Generate(r.storage, currentUser.Id)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to generate recovery codes: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+					"recoveryCodes": codes,
+				})
+			}
+
 			return ctx.SendStatus(fiber.StatusOK)
 		},
 	}
@@ -0,0 +1,50 @@
+package webauthn
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passkeys"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+type WebauthnRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+	webauthn   *webauthn.WebAuthn
+}
+
+func NewWebauthnRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	webauthn, err := passkeys.New()
+
+	if err != nil {
+		log.Errorf("🔥 Failed to configure WebAuthn: %s", err.Error())
+	}
+
+	return &WebauthnRouter{
+		storage:    storage,
+		middleware: middleware,
+		webauthn:   webauthn,
+	}
+}
+
+func (r *WebauthnRouter) LoadRoutes() []routing.Route {
+	if r.webauthn == nil {
+		return []routing.Route{}
+	}
+
+	routes := []routing.Route{
+		r.RegisterBeginRoute(),
+		r.RegisterFinishRoute(),
+		r.LoginBeginRoute(),
+		r.LoginFinishRoute(),
+		r.PasskeyBeginRoute(),
+		r.PasskeyFinishRoute(),
+		r.CredentialsRoute(),
+		r.DeleteCredentialRoute(),
+	}
+
+	return routes
+}
@@ -0,0 +1,45 @@
+package authentication
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+)
+
+func (r *AuthenticationRouter) newPassword(user *models.User, password string) ([]byte, error) {
+	if err := passwords.Validate(password, user.Email); err != nil {
+		return nil, err
+	}
+
+	reused, err := passwords.Reused(r.storage.Database(), user, password)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		return nil, &passwords.PolicyError{
+			Message: "You have used this password recently. Please choose a different password.",
+		}
+	}
+
+	return passwords.Hash(password)
+}
+
+func (r *AuthenticationRouter) rehashPassword(user *models.User, password string) error {
+	hash, err := passwords.Hash(password)
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.storage.Database().
+		Model(&models.User{}).
+		Where("id = ? AND password = ?", user.Id, user.Password).
+		Update("password", hash).Error; err != nil {
+		return err
+	}
+
+	user.Password = hash
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package authentication
+
+import (
+	"crypto/subtle"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"gorm.io/gorm"
+)
+
+func browserHash(ctx fiber.Ctx) ([]byte, error) {
+	session := session.FromContext(ctx)
+
+	if nonce, ok := session.Get(sessions.PasswordlessNonceKey).(string); ok && nonce != "" {
+		return tokens.Hash(nonce), nil
+	}
+
+	nonce, nonceHash, err := tokens.Generate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	session.Set(sessions.PasswordlessNonceKey, nonce)
+
+	return nonceHash, nil
+}
+
+func sameBrowser(ctx fiber.Ctx, loginCode *models.LoginCode) bool {
+	nonce, ok := session.FromContext(ctx).Get(sessions.PasswordlessNonceKey).(string)
+
+	if !ok || nonce == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(tokens.Hash(nonce), loginCode.BrowserHash) == 1
+}
+
+func (r *AuthenticationRouter) redeemLoginCode(loginCode *models.LoginCode) (*models.User, error) {
+	var user models.User
+
+	err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.LoginCode{}).
+			Where("id = ? AND used_at IS NULL AND expires_at > ?", loginCode.Id, time.Now()).
+			Update("used_at", time.Now())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ?", loginCode.UserId).
+			Update("email_verified", true).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", loginCode.UserId).First(&user).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (r *AuthenticationRouter) mfaMethods(user *models.User) ([]string, error) {
+	var passkeyCount int64
+
+	if err := r.storage.Database().
+		Model(&models.WebauthnCredential{}).
+		Where("user_id = ?", user.Id).
+		Count(&passkeyCount).Error; err != nil {
+		return nil, err
+	}
+
+	methods := []string{}
+
+	if user.TotpConfirmed {
+		methods = append(methods, "totp")
+	}
+
+	if passkeyCount > 0 {
+		methods = append(methods, "webauthn")
+	}
+
+	methods = append(methods, "recovery")
+
+	return methods, nil
+}
+
+func (r *AuthenticationRouter) startSession(ctx fiber.Ctx, user *models.User) error {
+	if !user.Active {
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "Forbidden",
+			"message": "Your account has been deactivated.",
+		})
+	}
+
+	if user.MfaEnabled {
+		if err := sessions.Challenge(ctx, r.storage, user.Id); err != nil {
+			log.Errorf("🔥 Failed to start MFA challenge: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": "Failed to save session.",
+			})
+		}
+
+		methods, err := r.mfaMethods(user)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to list MFA methods: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": "An error occurred while processing your request.",
+			})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+			"mfaRequired": true,
+			"mfaMethods":  methods,
+		})
+	}
+
+	if err := sessions.Authenticate(ctx, r.storage, user.Id); err != nil {
+		log.Errorf("🔥 Failed to start session: %s", err.Error())
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Internal Server Error",
+			"message": "Failed to save session.",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"mfaRequired": false,
+		"item":        user,
+	})
+}
@@ -0,0 +1,144 @@
+package authentication
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+// sender hands every message to sent and fails with err.
+type sender struct {
+	sent chan mail.Message
+	err  error
+}
+
+func (s *sender) Send(message mail.Message) error {
+	s.sent <- message
+
+	return s.err
+}
+
+// newLoginCodeDatabase answers the user lookup for known and the number of
+// pending login codes.
+func newLoginCodeDatabase(known *models.User, pending int64) *testutil.Database {
+	return &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			switch {
+			case statement.Contains("count(*)"):
+				return testutil.Count(pending)
+			case known != nil && statement.Contains(`FROM "users"`):
+				return testutil.Row(map[string]any{
+					"id":    known.Id.String(),
+					"name":  known.Name,
+					"email": known.Email,
+				})
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestRequestPasswordlessResponse(t *testing.T) {
+	user := &models.User{Base: models.Base{Id: uuid.New()}, Name: "Jane", Email: "jane@example.com"}
+
+	tests := []struct {
+		name  string
+		known *models.User
+		err   error
+		sends bool
+	}{
+		{"unknown email", nil, nil, false},
+		{"known email", user, nil, true},
+		{"known email when mail fails", user, errors.New("smtp unavailable"), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mailer := &sender{sent: make(chan mail.Message, 1), err: test.err}
+			router := &AuthenticationRouter{
+				storage: testutil.Fake(t, newLoginCodeDatabase(test.known, 0)),
+				mail:    mailer,
+			}
+			route := router.RequestPasswordlessRoute()
+
+			app := fiber.New()
+			app.Use(session.New())
+			app.Post("/authentication/passwordless/request", route.Handler)
+
+			request := httptest.NewRequest(fiber.MethodPost, "/authentication/passwordless/request", strings.NewReader(`{"email":"jane@example.com"}`))
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err := app.Test(request)
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != fiber.StatusOK {
+				t.Errorf("status = %d, want %d", response.StatusCode, fiber.StatusOK)
+			}
+
+			select {
+			case message := <-mailer.sent:
+				if !test.sends {
+					t.Errorf("sent %v, want no email", message.To)
+				}
+			case <-time.After(time.Second):
+				if test.sends {
+					t.Error("no email was sent")
+				}
+			}
+		})
+	}
+}
+
+func TestSendLoginCode(t *testing.T) {
+	t.Setenv("API_PASSWORDLESS_MAX_PENDING", "3")
+
+	user := &models.User{Base: models.Base{Id: uuid.New()}, Name: "Jane", Email: "jane@example.com"}
+	browserHash := []byte("browser")
+
+	tests := []struct {
+		name    string
+		pending int64
+		err     error
+	}{
+		{"no pending codes", 0, nil},
+		{"codes pending in other browsers", 2, nil},
+		{"too many pending codes", 3, ErrTooManyLoginCodes},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := newLoginCodeDatabase(nil, test.pending)
+			mailer := &sender{sent: make(chan mail.Message, 1)}
+			router := &AuthenticationRouter{storage: testutil.Fake(t, database), mail: mailer}
+
+			if err := router.sendLoginCode(user, browserHash); !errors.Is(err, test.err) {
+				t.Fatalf("sendLoginCode() error = %v, want %v", err, test.err)
+			}
+
+			if !database.Executed(`DELETE FROM "login_codes"`, "browser_hash = $2") {
+				t.Error("the browser's earlier code was not replaced")
+			}
+
+			if database.Executed(`DELETE FROM "login_codes" WHERE user_id = $1 AND used_at IS NULL`) {
+				t.Error("pending codes from other browsers were deleted")
+			}
+
+			if sent := len(mailer.sent) == 1; sent != (test.err == nil) {
+				t.Errorf("email sent = %v, want %v", sent, test.err == nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package sessions
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type SessionsRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func NewSessionsRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &SessionsRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *SessionsRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.ListRoute(),
+		r.RevokeRoute(),
+		r.RevokeOthersRoute(),
+	}
+
+	return routes
+}
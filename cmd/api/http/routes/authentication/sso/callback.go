@@ -0,0 +1,238 @@
+package sso
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+func (r *SsoRouter) CallbackRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The user has been signed in and redirected.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "SSO Callback",
+			Description: "Completes the authorization code flow, links the identity to an existing user by stored subject or verified email, and redirects to the original destination.",
+			Tags:        []string{"Authentication"},
+			Parameters: []*openapi3.ParameterRef{
+				{
+					Ref: "#/components/parameters/Provider",
+				},
+				{
+					Ref: "#/components/parameters/Code",
+				},
+				{
+					Ref: "#/components/parameters/State",
+				},
+			},
+			RequestBody: nil,
+			Responses:   responses,
+		},
+		Method:      routing.GET,
+		Path:        "/authentication/sso/{provider}/callback",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			providerId := ctx.Params("provider")
+
+			state, ok := loadState(ctx)
+
+			if !ok ||
+				state.Provider != providerId ||
+				subtle.ConstantTimeCompare([]byte(state.State), []byte(ctx.Query("state"))) != 1 {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid or expired sign in attempt. Please try again.",
+				})
+			}
+
+			if ctx.Query("code") == "" {
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "The identity provider did not authorize the sign in.",
+				})
+			}
+
+			identity, err := r.sso.Exchange(ctx, providerId, ctx.Query("code"), state.Nonce, state.Verifier)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to complete SSO login: %s", err.Error())
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "The identity provider did not authorize the sign in.",
+				})
+			}
+
+			var user models.User
+
+			err = r.storage.Database().
+				Joins("JOIN user_identities ON user_identities.user_id = users.id").
+				Where("user_identities.provider = ? AND user_identities.subject = ?", identity.Provider, identity.Subject).
+				First(&user).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				if !identity.EmailVerified || identity.Email == "" {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "The identity provider did not supply a verified email address.",
+					})
+				}
+
+				err = r.storage.Database().
+					Where("LOWER(email) = ?", identity.Email).
+					First(&user).Error
+
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "No account is associated with this identity.",
+					})
+				}
+
+				if err == nil {
+					err = r.storage.Database().Transaction(func(tx *gorm.DB) error {
+						if err := tx.Create(&models.UserIdentity{
+							UserId:   user.Id,
+							Provider: identity.Provider,
+							Subject:  identity.Subject,
+							Email:    identity.Email,
+						}).Error; err != nil {
+							return err
+						}
+
+						if user.EmailVerified {
+							return nil
+						}
+
+						user.EmailVerified = true
+
+						return tx.Model(&models.User{}).
+							Where("id = ?", user.Id).
+							Update("email_verified", true).Error
+					})
+				}
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to resolve SSO user: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if !user.Active {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Your account has been deactivated.",
+				})
+			}
+
+			if user.MfaEnabled {
+				err = sessions.Challenge(ctx, r.storage, user.Id)
+			} else {
+				err = sessions.Authenticate(ctx, r.storage, user.Id)
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to start session: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "Failed to save session.",
+				})
+			}
+
+			return ctx.Redirect().Status(fiber.StatusFound).To(state.To)
+		},
+	}
+}
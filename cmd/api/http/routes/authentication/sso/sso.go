@@ -0,0 +1,35 @@
+package sso
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sso"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type SsoRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+	sso        sso.Sso
+}
+
+func NewSsoRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	sso := sso.New()
+
+	return &SsoRouter{
+		storage:    storage,
+		middleware: middleware,
+		sso:        sso,
+	}
+}
+
+func (r *SsoRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.ProvidersRoute(),
+		r.LoginRoute(),
+		r.CallbackRoute(),
+	}
+
+	return routes
+}
@@ -0,0 +1,66 @@
+package sso
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+)
+
+const stateKey = "sso_state"
+
+type loginState struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	To       string `json:"to"`
+}
+
+func saveState(ctx fiber.Ctx, state loginState) error {
+	encoded, err := json.Marshal(state)
+
+	if err != nil {
+		return err
+	}
+
+	session.FromContext(ctx).Set(stateKey, string(encoded))
+
+	return nil
+}
+
+func loadState(ctx fiber.Ctx) (*loginState, bool) {
+	session := session.FromContext(ctx)
+
+	encoded, ok := session.Get(stateKey).(string)
+
+	session.Delete(stateKey)
+
+	if !ok || encoded == "" {
+		return nil, false
+	}
+
+	var state loginState
+
+	if err := json.Unmarshal([]byte(encoded), &state); err != nil {
+		return nil, false
+	}
+
+	return &state, true
+}
+
+func redirectTarget(to string) string {
+	appBaseUrl := strings.TrimSuffix(common.EnvString("APP_BASE_URL", "http://localhost:3000"), "/")
+
+	if strings.HasPrefix(to, "/") && !strings.HasPrefix(to, "//") && !strings.HasPrefix(to, "/\\") {
+		return appBaseUrl + to
+	}
+
+	if to == appBaseUrl || strings.HasPrefix(to, appBaseUrl+"/") || strings.HasPrefix(to, appBaseUrl+"?") {
+		return to
+	}
+
+	return appBaseUrl
+}
@@ -0,0 +1,65 @@
+package tokens
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestCreateScopes(t *testing.T) {
+	user := &models.User{
+		Base: models.Base{Id: uuid.New()},
+		Type: models.UserTypeOrganizationUser,
+		Roles: []models.Role{
+			{Permissions: []string{"users.**", "!users.delete"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		scopes string
+		status int
+	}{
+		{"held scopes", `["users.list","users.roles.assign"]`, fiber.StatusOK},
+		{"a denial", `["users.list","!users.update"]`, fiber.StatusOK},
+		{"a scope not held", `["roles.list"]`, fiber.StatusForbidden},
+		{"a pattern covering a denied permission", `["users.**"]`, fiber.StatusForbidden},
+		{"a wildcard", `["*"]`, fiber.StatusForbidden},
+		{"a denied permission", `["users.delete"]`, fiber.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storage := testutil.Fake(t, &testutil.Database{})
+			router := &TokensRouter{storage: storage, middleware: middleware.New(storage)}
+			route := router.CreateRoute()
+
+			app := fiber.New()
+
+			app.Post("/authentication/tokens", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", user)
+
+				return ctx.Next()
+			}, route.Handler)
+
+			request := httptest.NewRequest(fiber.MethodPost, "/authentication/tokens", strings.NewReader(`{"name":"CI","scopes":`+test.scopes+`}`))
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err := app.Test(request)
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package tokens
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type TokensRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func NewTokensRouter(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &TokensRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *TokensRouter) LoadRoutes() []routing.Route {
+	routes := []routing.Route{
+		r.ListRoute(),
+		r.CreateRoute(),
+		r.RevokeRoute(),
+	}
+
+	return routes
+}
@@ -0,0 +1,273 @@
+package authentication
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/attempts"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"gorm.io/gorm"
+)
+
+type VerifyPasswordlessCodePayload struct {
+	Code string `json:"code"`
+}
+
+func (r *AuthenticationRouter) VerifyPasswordlessCodeRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The user is logged in, or a Multi-Factor Authentication challenge is required.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("429", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Too Many Requests").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Verify Passwordless Code",
+			Description: "Logs in with the numeric code from a passwordless sign-in email, entered in the browser that requested it. The code is invalidated after too many wrong guesses. When Multi-Factor Authentication is enabled a challenge is returned instead of a full session.",
+			Tags:        []string{"Authentication"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/VerifyPasswordlessCodePayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/authentication/passwordless/code",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload VerifyPasswordlessCodePayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || strings.TrimSpace(payload.Code) == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			ipKey := attempts.Ip(ctx.IP())
+
+			retryAfter, err := attempts.Check(r.storage, ipKey)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to check login attempts: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if retryAfter > 0 {
+				ctx.Set(fiber.HeaderRetryAfter, attempts.RetryAfter(retryAfter))
+
+				return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"error":   "Too Many Requests",
+					"message": "Too many failed login attempts. Please try again later.",
+				})
+			}
+
+			nonce, ok := session.FromContext(ctx).Get(sessions.PasswordlessNonceKey).(string)
+
+			if !ok || nonce == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Please request a new sign-in code from this browser.",
+				})
+			}
+
+			var loginCode models.LoginCode
+
+			if err := r.storage.Database().
+				Where("browser_hash = ? AND used_at IS NULL AND expires_at > ?", tokens.Hash(nonce), time.Now()).
+				Order("created_at DESC").
+				First(&loginCode).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The sign-in code is invalid or has expired.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve login code from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			maxFailures := common.EnvInt("API_PASSWORDLESS_MAX_FAILURES", 5)
+
+			var failures []int
+
+			if err := r.storage.Database().
+				Raw(
+					"UPDATE login_codes SET failures = failures + 1 WHERE id = ? AND used_at IS NULL AND failures < ? RETURNING failures",
+					loginCode.Id,
+					maxFailures,
+				).
+				Scan(&failures).Error; err != nil {
+				log.Errorf("🔥 Failed to record login code attempt: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if len(failures) == 0 {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "The sign-in code is invalid or has expired.",
+				})
+			}
+
+			if subtle.ConstantTimeCompare(tokens.Hash(strings.TrimSpace(payload.Code)), loginCode.CodeHash) != 1 {
+				if failures[0] >= maxFailures {
+					if err := r.storage.Database().
+						Model(&models.LoginCode{}).
+						Where("id = ?", loginCode.Id).
+						Update("used_at", time.Now()).Error; err != nil {
+						log.Errorf("🔥 Failed to invalidate login code: %s", err.Error())
+					}
+				}
+
+				if err := attempts.Fail(r.storage, attempts.Failure{
+					Reason:    "passwordless",
+					UserId:    &loginCode.UserId,
+					IpAddress: ctx.IP(),
+				}, ipKey); err != nil {
+					log.Errorf("🔥 Failed to record login attempt: %s", err.Error())
+				}
+
+				return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error":   "Unauthorized",
+					"message": "The sign-in code is invalid or has expired.",
+				})
+			}
+
+			user, err := r.redeemLoginCode(&loginCode)
+
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The sign-in code is invalid or has expired.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to redeem login code: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			session.FromContext(ctx).Delete(sessions.PasswordlessNonceKey)
+
+			return r.startSession(ctx, user)
+		},
+	}
+}
@@ -0,0 +1,337 @@
+package organizations
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/impersonation"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/passwords"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrInvitationUnavailable = errors.New("the invitation is no longer pending")
+
+type AcceptInvitationPayload struct {
+	Token    string `json:"token"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+func (r *OrganizationsRouter) AcceptInvitationRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The invitation has been accepted and the account has joined the organization.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Accept Invitation",
+			Description: "Accepts an organization invitation. When logged in as the invited email address the current account joins the organization. Otherwise a new account is created from the supplied name and password. If an account already exists for the email the user must log in first.",
+			Tags:        []string{"Organizations"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/AcceptInvitationPayload",
+			},
+			Responses: responses,
+		},
+		Method:      routing.POST,
+		Path:        "/organizations/invitations/accept",
+		Middlewares: []fiber.Handler{},
+		Handler: func(ctx fiber.Ctx) error {
+			var payload AcceptInvitationPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil || strings.TrimSpace(payload.Token) == "" {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			var invitation models.OrganizationInvitation
+
+			if err := r.storage.Database().
+				Where("token_hash = ? AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", tokens.Hash(strings.TrimSpace(payload.Token)), time.Now()).
+				Preload("Organization").
+				Preload("Role").
+				First(&invitation).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The invitation is invalid or has expired.",
+					})
+				}
+
+				log.Errorf("🔥 Failed to retrieve invitation from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if _, impersonating := impersonation.Current(ctx); impersonating {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Invitations cannot be accepted while impersonating a user.",
+				})
+			}
+
+			var user models.User
+			var password []byte
+
+			if currentUserId := session.FromContext(ctx).Get(sessions.UserIdKey); currentUserId != nil {
+				if err := r.storage.Database().Where("id = ?", currentUserId).First(&user).Error; err != nil {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "You must be logged in to access this resource.",
+					})
+				}
+
+				if !user.Active || (user.MfaEnabled && !sessions.MfaVerified(ctx)) {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "You must be logged in to access this resource.",
+					})
+				}
+
+				if !strings.EqualFold(user.Email, invitation.Email) {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "This invitation was sent to a different email address.",
+					})
+				}
+			} else {
+				var existingUsers int64
+
+				if err := r.storage.Database().
+					Model(&models.User{}).
+					Where("LOWER(email) = LOWER(?)", invitation.Email).
+					Count(&existingUsers).Error; err != nil {
+					log.Errorf("🔥 Failed to retrieve user from database: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				if existingUsers > 0 {
+					return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error":   "Unauthorized",
+						"message": "An account already exists for this email address. Please log in to accept the invitation.",
+					})
+				}
+
+				payload.Name = strings.TrimSpace(payload.Name)
+
+				if len(payload.Name) < 3 {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "Please provide a name of at least 3 characters.",
+					})
+				}
+
+				if err := passwords.Validate(payload.Password, invitation.Email); err != nil {
+					var policyErr *passwords.PolicyError
+
+					if errors.As(err, &policyErr) {
+						return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+							"error":   "Bad Request",
+							"message": policyErr.Message,
+						})
+					}
+
+					log.Errorf("🔥 Failed to evaluate password policy: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				hash, err := passwords.Hash(payload.Password)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to hash password: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				password = hash
+
+				user = models.User{
+					Name:          payload.Name,
+					Email:         invitation.Email,
+					EmailVerified: true,
+					Password:      password,
+					Type: models.UserType(common.EnvString(
+						"API_DEFAULT_USER_TYPE",
+						string(models.UserTypeOrganizationUser),
+					)),
+				}
+			}
+
+			err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				if user.Id == uuid.Nil {
+					if err := tx.Create(&user).Error; err != nil {
+						return err
+					}
+
+					if err := passwords.Remember(tx, user.Id, password); err != nil {
+						return err
+					}
+				}
+
+				result := tx.Model(&models.OrganizationInvitation{}).
+					Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", invitation.Id).
+					Updates(map[string]any{
+						"accepted_at":    time.Now(),
+						"accepted_by_id": user.Id,
+					})
+
+				if result.Error != nil {
+					return result.Error
+				}
+
+				if result.RowsAffected == 0 {
+					return ErrInvitationUnavailable
+				}
+
+				if err := tx.Exec(
+					"INSERT INTO organizations_members (organization_id, user_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					invitation.OrganizationId,
+					user.Id,
+				).Error; err != nil {
+					return err
+				}
+
+				if err := tx.Exec(
+					"INSERT INTO organizations_roles (organization_id, role_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					invitation.OrganizationId,
+					invitation.RoleId,
+				).Error; err != nil {
+					return err
+				}
+
+				return tx.Exec(
+					"INSERT INTO users_roles (user_id, role_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					user.Id,
+					invitation.RoleId,
+				).Error
+			})
+
+			if errors.Is(err, ErrInvitationUnavailable) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "The invitation is invalid or has expired.",
+				})
+			}
+
+			if err != nil {
+				log.Errorf("🔥 Failed to accept invitation: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+				"item":         user,
+				"organization": invitation.Organization,
+			})
+		},
+	}
+}
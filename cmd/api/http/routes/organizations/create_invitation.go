@@ -0,0 +1,271 @@
+package organizations
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/google/uuid"
+)
+
+type CreateInvitationPayload struct {
+	Email     string     `json:"email"`
+	RoleId    uuid.UUID  `json:"roleId"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (r *OrganizationsRouter) CreateInvitationRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The invitation has been created and emailed.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Create Invitation",
+			Description: "Invites someone to the organization by email with a role. The role must be global or owned by this organization. Unless the inviter is a system administrator, they must hold every permission the role grants, and the role cannot cover anything they are denied. Any earlier pending invitation for the same email is replaced.",
+			Tags:        []string{"Organizations"},
+			Parameters: []*openapi3.ParameterRef{
+				{
+					Ref: "#/components/parameters/Id",
+				},
+			},
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/CreateInvitationPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.POST,
+		Path:   "/organizations/{id}/invitations",
+		Middlewares: []fiber.Handler{
+			r.middleware.Authenticated(),
+			r.manageInvitations("organizations.invitations.create"),
+		},
+		Permissions:         []string{"organizations.invitations.create"},
+		CustomAuthorization: true,
+		Handler: func(ctx fiber.Ctx) error {
+			currentUser := ctx.Locals("user").(*models.User)
+			organization := ctx.Locals("organization").(*models.Organization)
+
+			var payload CreateInvitationPayload
+
+			if err := ctx.Bind().Body(&payload); err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			payload.Email = strings.TrimSpace(payload.Email)
+
+			address, err := mail.ParseAddress(payload.Email)
+
+			if err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Please provide a valid email address.",
+				})
+			}
+
+			payload.Email = strings.ToLower(address.Address)
+
+			expiresAt := time.Now().Add(invitationTtl())
+
+			if payload.ExpiresAt != nil {
+				if !payload.ExpiresAt.After(time.Now()) {
+					return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+						"error":   "Bad Request",
+						"message": "The expiry date must be in the future.",
+					})
+				}
+
+				expiresAt = *payload.ExpiresAt
+			}
+
+			var role models.Role
+
+			if err := r.storage.Database().
+				Where("id = ?", payload.RoleId).
+				First(&role).Error; err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "The role was not found.",
+				})
+			}
+
+			if role.OrganizationId != nil && *role.OrganizationId != organization.Id {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "The role belongs to another organization.",
+				})
+			}
+
+			if apiToken, ok := ctx.Locals("api_token").(*models.ApiToken); ok && !permissions.Grantable(apiToken.Scopes, role.Permissions) {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "This API token is not scoped to invite someone with the " + role.Name + " role.",
+				})
+			}
+
+			if currentUser.Type != models.UserTypeSystemAdmin {
+				evaluation, err := permissions.Evaluate(r.storage.Database(), currentUser, &organization.Id)
+
+				if err != nil {
+					log.Errorf("🔥 Failed to evaluate permissions: %s", err.Error())
+
+					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": "An error occurred while processing your request.",
+					})
+				}
+
+				if !permissions.Grantable(evaluation.Permissions, role.Permissions) {
+					return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"error":   "Forbidden",
+						"message": "You cannot invite someone with the " + role.Name + " role because your roles do not include all of its permissions.",
+					})
+				}
+			}
+
+			var existingMembers int64
+
+			if err := r.storage.Database().
+				Table("organizations_members").
+				Joins("JOIN users ON users.id = organizations_members.user_id").
+				Where("organizations_members.organization_id = ? AND LOWER(users.email) = LOWER(?)", organization.Id, payload.Email).
+				Count(&existingMembers).Error; err != nil {
+				log.Errorf("🔥 Failed to retrieve organization membership: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			if existingMembers > 0 {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "This person is already a member of the organization.",
+				})
+			}
+
+			if err := r.storage.Database().
+				Model(&models.OrganizationInvitation{}).
+				Where("organization_id = ? AND LOWER(email) = LOWER(?) AND accepted_at IS NULL AND revoked_at IS NULL", organization.Id, payload.Email).
+				Update("revoked_at", time.Now()).Error; err != nil {
+				log.Errorf("🔥 Failed to revoke previous invitations: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			invitation := models.OrganizationInvitation{
+				OrganizationId: organization.Id,
+				Email:          payload.Email,
+				RoleId:         role.Id,
+				Role:           role,
+				InvitedById:    &currentUser.Id,
+				ExpiresAt:      expiresAt,
+			}
+
+			if err := r.sendInvitation(&invitation, organization, currentUser); err != nil {
+				log.Errorf("🔥 Failed to send invitation: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+				"item": invitation,
+			})
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package organizations
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func invitationTtl() time.Duration {
+	return common.EnvDuration("API_INVITATION_TTL", 7*24*time.Hour)
+}
+
+func (r *OrganizationsRouter) manageInvitations(permission string) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		currentUser := ctx.Locals("user").(*models.User)
+
+		var organization models.Organization
+
+		if err := r.storage.Database().
+			Where("id = ?", ctx.Params("id")).
+			First(&organization).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"error":   "Not Found",
+					"message": "The organization was not found.",
+				})
+			}
+
+			log.Errorf("🔥 Failed to retrieve organization from database: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": "An error occurred while processing your request.",
+			})
+		}
+
+		allowed := organization.OwnerId == currentUser.Id || currentUser.Type == models.UserTypeSystemAdmin
+
+		if !allowed {
+			evaluation, err := permissions.Evaluate(r.storage.Database(), currentUser, &organization.Id)
+
+			if err != nil {
+				log.Errorf("🔥 Failed to evaluate permissions: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			allowed = evaluation.OrganizationId != nil && permissions.Allowed(evaluation.Permissions, permission)
+		}
+
+		if apiToken, ok := ctx.Locals("api_token").(*models.ApiToken); ok && !permissions.Allowed(apiToken.Scopes, permission) {
+			allowed = false
+		}
+
+		if !allowed {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "You do not have permission to manage this organization's invitations.",
+			})
+		}
+
+		ctx.Locals("organization", &organization)
+
+		return ctx.Next()
+	}
+}
+
+func (r *OrganizationsRouter) sendInvitation(invitation *models.OrganizationInvitation, organization *models.Organization, inviter *models.User) error {
+	token, tokenHash, err := tokens.Generate()
+
+	if err != nil {
+		return err
+	}
+
+	invitation.TokenHash = tokenHash
+	invitation.SentAt = time.Now()
+
+	if err := r.storage.Database().Omit(clause.Associations).Save(invitation).Error; err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf(
+		"%s/invitations/accept?token=%s",
+		common.EnvString("APP_BASE_URL", "http://localhost:3000"),
+		url.QueryEscape(token),
+	)
+
+	return r.mail.Send(mail.Message{
+		To:      []string{invitation.Email},
+		Subject: fmt.Sprintf("You have been invited to join %s", organization.Name),
+		Text: fmt.Sprintf(
+			"Hi,\n\n%s has invited you to join %s as %s. Accept the invitation by opening the link below:\n\n%s\n\nThis invitation expires at %s. If you were not expecting it you can ignore this email.",
+			inviter.Name,
+			organization.Name,
+			invitation.Role.Name,
+			link,
+			invitation.ExpiresAt.Format(time.RFC1123),
+		),
+	})
+}
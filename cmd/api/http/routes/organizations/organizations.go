@@ -5,6 +5,7 @@ import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/assignApi"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/baseApi"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
@@ -13,21 +14,24 @@ import (
 type OrganizationsRouter struct {
 	storage    storage.Storage
 	middleware middleware.Middleware
+	mail       mail.Sender
 }
 
-func New(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+func New(storage storage.Storage, middleware middleware.Middleware, mail mail.Sender) routes.Router {
 	return &OrganizationsRouter{
 		storage:    storage,
 		middleware: middleware,
+		mail:       mail,
 	}
 }
 
 func (r *OrganizationsRouter) LoadRoutes() []routing.Route {
-	organizationUserAssignmentApi := assignApi.New[models.Organization, models.User](
+	organizationUserAssignmentApi := assignApi.NewWithAssociation[models.Organization, models.User](
 		r.storage,
 		"/organizations",
 		"Organization",
 		"User",
+		"Members",
 	)
 	organizationRoleAssignmentApi := assignApi.New[models.Organization, models.Role](
 		r.storage,
@@ -35,56 +39,92 @@ func (r *OrganizationsRouter) LoadRoutes() []routing.Route {
 		"Organization",
 		"Role",
 	)
-	organizationsApi := baseApi.New[models.Organization](r.storage, "/organizations", "Organization")
+	organizationsApi := baseApi.New[models.Organization](
+		r.storage,
+		"/organizations",
+		"Organization",
+		baseApi.WithTenancy(
+			baseApi.OrganizationColumn("id"),
+		),
+		baseApi.WithPreloads("Owner", "Members"),
+	)
 
 	return []routing.Route{
+		r.ListScimTokensRoute(),
+		r.CreateScimTokenRoute(),
+		r.DeleteScimTokenRoute(),
+
+		r.AcceptInvitationRoute(),
+		r.ListInvitationsRoute(),
+		r.CreateInvitationRoute(),
+		r.ResendInvitationRoute(),
+		r.RevokeInvitationRoute(),
+
 		organizationUserAssignmentApi.AssignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.users.assign"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+		).WithPermissions("organizations.users.assign"),
 		organizationUserAssignmentApi.UnassignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.users.unassign"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+		).WithPermissions("organizations.users.unassign"),
 		organizationUserAssignmentApi.ListRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.users.list"),
-		),
+		).WithPermissions("organizations.users.list"),
 
 		organizationRoleAssignmentApi.AssignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.roles.assign"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+			r.middleware.Grantable("roleId"),
+		).WithPermissions("organizations.roles.assign"),
 		organizationRoleAssignmentApi.UnassignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.roles.unassign"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+		).WithPermissions("organizations.roles.unassign"),
 		organizationRoleAssignmentApi.ListRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.roles.list"),
-		),
+		).WithPermissions("organizations.roles.list"),
 
 		organizationsApi.GetAllRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.list"),
-		),
+		).WithPermissions("organizations.list"),
 		organizationsApi.GetOneRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.view"),
-		),
+		).WithPermissions("organizations.view"),
 		organizationsApi.CreateRoute(
 			"#/components/requestBodies/CreateOrganizationPayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.create"),
-		),
+		).WithPermissions("organizations.create"),
 		organizationsApi.UpdateRoute(
 			"#/components/requestBodies/UpdateOrganizationPayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.update"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+			),
+		).WithPermissions("organizations.update"),
 		organizationsApi.DeleteRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("organizations.delete"),
-		),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+			),
+		).WithPermissions("organizations.delete"),
 	}
 }
@@ -0,0 +1,29 @@
+package permissions
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type PermissionsRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+	catalog    *permissions.Catalog
+}
+
+func New(storage storage.Storage, middleware middleware.Middleware, catalog *permissions.Catalog) routes.Router {
+	return &PermissionsRouter{
+		storage:    storage,
+		middleware: middleware,
+		catalog:    catalog,
+	}
+}
+
+func (r *PermissionsRouter) LoadRoutes() []routing.Route {
+	return []routing.Route{
+		r.ListRoute(),
+	}
+}
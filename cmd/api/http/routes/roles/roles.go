@@ -5,6 +5,7 @@ import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/baseApi"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 )
@@ -12,40 +13,46 @@ import (
 type RolesRouter struct {
 	storage    storage.Storage
 	middleware middleware.Middleware
+	catalog    *permissions.Catalog
 }
 
-func New(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+func New(storage storage.Storage, middleware middleware.Middleware, catalog *permissions.Catalog) routes.Router {
 	return &RolesRouter{
 		storage:    storage,
 		middleware: middleware,
+		catalog:    catalog,
 	}
 }
 
 func (r *RolesRouter) LoadRoutes() []routing.Route {
-	rolesApi := baseApi.New[models.Role](r.storage, "/roles", "Role")
+	rolesApi := baseApi.New[models.Role](
+		r.storage,
+		"/roles",
+		"Role",
+		baseApi.WithTenancy(
+			baseApi.OrganizationColumn("organization_id").WithGlobal(),
+		),
+	)
 
 	return []routing.Route{
 		rolesApi.GetAllRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("roles.list"),
-		),
+		).WithPermissions("roles.list"),
 		rolesApi.GetOneRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("roles.view"),
-		),
-		rolesApi.CreateRoute(
+		).WithPermissions("roles.view"),
+		r.grantable(rolesApi.CreateRoute(
 			"#/components/requestBodies/CreateRolePayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("roles.create"),
-		),
-		rolesApi.UpdateRoute(
+			r.validatePermissions(),
+		)).WithPermissions("roles.create"),
+		r.grantable(rolesApi.UpdateRoute(
 			"#/components/requestBodies/UpdateRolePayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("roles.update"),
-		),
+			r.validatePermissions(),
+		)).WithPermissions("roles.update"),
 		rolesApi.DeleteRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("roles.delete"),
-		),
+		).WithPermissions("roles.delete"),
 	}
 }
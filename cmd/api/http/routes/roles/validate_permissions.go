@@ -0,0 +1,121 @@
+package roles
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+type RolePermissionsPayload struct {
+	Permissions []string `json:"permissions"`
+}
+
+func (r *RolesRouter) validatePermissions() fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		var payload RolePermissionsPayload
+
+		if err := ctx.Bind().Body(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Bad Request",
+				"message": "Invalid request body.",
+			})
+		}
+
+		unknown := r.catalog.Unknown(payload.Permissions)
+
+		if len(unknown) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Bad Request",
+				"message": fmt.Sprintf("Unknown permissions: %s.", strings.Join(unknown, ", ")),
+			})
+		}
+
+		return ctx.Next()
+	}
+}
+
+// grantable wraps the route's handler, so that it runs after Authorized has
+// evaluated the caller's permissions, and only lets the caller add
+// permissions to a role that they, and their API token, already hold.
+// Permissions the role already grants can be kept, and any can be removed.
+// System admins can add any permission.
+func (r *RolesRouter) grantable(route routing.Route) routing.Route {
+	next := route.Handler
+
+	route.Handler = func(ctx fiber.Ctx) error {
+		currentUser, ok := ctx.Locals("user").(*models.User)
+
+		if !ok || currentUser == nil {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must be logged in to access this resource.",
+			})
+		}
+
+		var payload RolePermissionsPayload
+
+		if err := ctx.Bind().Body(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Bad Request",
+				"message": "Invalid request body.",
+			})
+		}
+
+		added := payload.Permissions
+
+		if id := ctx.Params("id"); id != "" {
+			var role models.Role
+
+			if err := r.storage.Database().
+				Where("id = ?", id).
+				First(&role).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Errorf("🔥 Failed to retrieve role from database: %s", err.Error())
+
+				return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error":   "Internal Server Error",
+					"message": "An error occurred while processing your request.",
+				})
+			}
+
+			added = slices.DeleteFunc(slices.Clone(added), func(permission string) bool {
+				return slices.Contains(role.Permissions, permission)
+			})
+		}
+
+		if len(added) == 0 {
+			return next(ctx)
+		}
+
+		if apiToken, ok := ctx.Locals("api_token").(*models.ApiToken); ok && !permissions.Grantable(apiToken.Scopes, added) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "This API token is not scoped to grant every permission of this role.",
+			})
+		}
+
+		if currentUser.Type == models.UserTypeSystemAdmin {
+			return next(ctx)
+		}
+
+		granted, _ := ctx.Locals("permissions").([]string)
+
+		if !permissions.Grantable(granted, added) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error":   "Forbidden",
+				"message": "You cannot give a role permissions that your own roles do not include.",
+			})
+		}
+
+		return next(ctx)
+	}
+
+	return route
+}
@@ -0,0 +1,213 @@
+package roles
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// request sends body through handlers with the given locals and returns the
+// status.
+func request(t *testing.T, method string, path string, body string, locals map[string]any, handlers ...fiber.Handler) int {
+	t.Helper()
+
+	app := fiber.New()
+
+	app.Add([]string{method}, "/roles/:id?", func(ctx fiber.Ctx) error {
+		for key, value := range locals {
+			ctx.Locals(key, value)
+		}
+
+		return ctx.Next()
+	}, handlers...)
+
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := app.Test(request)
+
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	return response.StatusCode
+}
+
+func accepted(ctx fiber.Ctx) error {
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func TestValidatePermissions(t *testing.T) {
+	catalog := permissions.NewCatalog()
+
+	catalog.Register("roles.list", "", "GET /roles")
+	catalog.Register("users.impersonate", "", "POST /impersonation")
+
+	router := &RolesRouter{catalog: catalog}
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"known permissions", `{"permissions":["roles.list","users.impersonate"]}`, fiber.StatusOK},
+		{"wildcards over known permissions", `{"permissions":["roles.*","*","!users.impersonate"]}`, fiber.StatusOK},
+		{"no permissions", `{}`, fiber.StatusOK},
+		{"unknown permission", `{"permissions":["roles.list","roles.destroy"]}`, fiber.StatusBadRequest},
+		{"malformed body", `{"permissions":"roles.list"}`, fiber.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := request(t, fiber.MethodPost, "/roles", test.body, nil, router.validatePermissions(), accepted)
+
+			if status != test.status {
+				t.Errorf("status = %d, want %d", status, test.status)
+			}
+		})
+	}
+}
+
+func TestGrantable(t *testing.T) {
+	roleId := uuid.New()
+	organizationUser := &models.User{Type: models.UserTypeOrganizationUser}
+	systemAdmin := &models.User{Type: models.UserTypeSystemAdmin}
+
+	// The role being updated already grants users.impersonate.
+	database := &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			if !statement.Contains(`FROM "roles"`) {
+				return nil
+			}
+
+			return testutil.Row(map[string]any{
+				"id":          roleId.String(),
+				"name":        "Support",
+				"permissions": "{users.impersonate}",
+			})
+		},
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		locals map[string]any
+		status int
+	}{
+		{
+			name:   "create with held permissions",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["roles.list","roles.view"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*"}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "create with a wildcard",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["*"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*"}},
+			status: fiber.StatusForbidden,
+		},
+		{
+			name:   "create with a permission not held",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["roles.list","users.impersonate"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*"}},
+			status: fiber.StatusForbidden,
+		},
+		{
+			name:   "create with a pattern covering a denied permission",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["users.**"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"users.**", "!users.type.update"}},
+			status: fiber.StatusForbidden,
+		},
+		{
+			name:   "create with only denials",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["!users.impersonate"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*"}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "system admin creates a wildcard role",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["*"]}`,
+			locals: map[string]any{"user": systemAdmin, "permissions": []string{"*"}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "API token without the scope",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["users.impersonate"]}`,
+			locals: map[string]any{"user": systemAdmin, "permissions": []string{"*"}, "api_token": &models.ApiToken{Scopes: []string{"roles.*"}}},
+			status: fiber.StatusForbidden,
+		},
+		{
+			name:   "update keeps a permission the role already grants",
+			method: fiber.MethodPut,
+			path:   "/roles/" + roleId.String(),
+			body:   `{"permissions":["users.impersonate","roles.list"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*"}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "update removes permissions",
+			method: fiber.MethodPut,
+			path:   "/roles/" + roleId.String(),
+			body:   `{"permissions":[]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "update without permissions",
+			method: fiber.MethodPut,
+			path:   "/roles/" + roleId.String(),
+			body:   `{"name":"Support"}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{}},
+			status: fiber.StatusOK,
+		},
+		{
+			name:   "update adds a permission not held",
+			method: fiber.MethodPut,
+			path:   "/roles/" + roleId.String(),
+			body:   `{"permissions":["users.impersonate","users.type.update"]}`,
+			locals: map[string]any{"user": organizationUser, "permissions": []string{"roles.*", "users.impersonate"}},
+			status: fiber.StatusForbidden,
+		},
+		{
+			name:   "not logged in",
+			method: fiber.MethodPost,
+			path:   "/roles",
+			body:   `{"permissions":["roles.list"]}`,
+			status: fiber.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := &RolesRouter{storage: testutil.Fake(t, database)}
+			route := router.grantable(routing.Route{Handler: accepted})
+
+			if status := request(t, test.method, test.path, test.body, test.locals, route.Handler); status != test.status {
+				t.Errorf("status = %d, want %d", status, test.status)
+			}
+		})
+	}
+}
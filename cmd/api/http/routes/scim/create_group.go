@@ -0,0 +1,172 @@
+package scim
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (r *ScimRouter) CreateGroupRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The group has been provisioned.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Create SCIM Group",
+			Description: "Creates a role for the token's organization and assigns it to the given members. New roles have no permissions until an administrator grants them.",
+			Tags:        []string{"SCIM"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/ScimGroupPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.POST,
+		Path:   "/scim/v2/Groups",
+		Middlewares: []fiber.Handler{
+			r.middleware.Provisioned(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			organization := organization(ctx)
+
+			var payload scim.Group
+
+			if err := bind(ctx, &payload); err != nil {
+				return fail(ctx, err)
+			}
+
+			if strings.TrimSpace(payload.DisplayName) == "" {
+				return fail(ctx, scim.NewError(fiber.StatusBadRequest, scim.ErrorTypeInvalidValue, "displayName is required."))
+			}
+
+			role := models.Role{
+				Name:           strings.TrimSpace(payload.DisplayName),
+				OrganizationId: &organization.Id,
+			}
+
+			if err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				if err := groupNameTaken(tx, role.Name, uuid.Nil); err != nil {
+					return err
+				}
+
+				userIds, err := memberIds(tx, organization.Id, payload.MemberIds())
+
+				if err != nil {
+					return err
+				}
+
+				if err := tx.Create(&role).Error; err != nil {
+					return err
+				}
+
+				if err := tx.Exec(
+					"INSERT INTO organizations_roles (organization_id, role_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					organization.Id,
+					role.Id,
+				).Error; err != nil {
+					return err
+				}
+
+				return addMembers(tx, role.Id, userIds)
+			}); err != nil {
+				return fail(ctx, err)
+			}
+
+			resource, err := groupResource(r.storage.Database(), organization.Id, &role)
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			ctx.Set(fiber.HeaderLocation, resource.Meta.Location)
+
+			return respond(ctx, fiber.StatusCreated, resource)
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package scim
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"gorm.io/gorm"
+)
+
+func (r *ScimRouter) CreateUserRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The user has been provisioned.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Create SCIM User",
+			Description: "Provisions a user into the token's organization. An existing account with the same email is added to the organization instead of being duplicated.",
+			Tags:        []string{"SCIM"},
+			Parameters:  nil,
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/ScimUserPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.POST,
+		Path:   "/scim/v2/Users",
+		Middlewares: []fiber.Handler{
+			r.middleware.Provisioned(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			organization := organization(ctx)
+
+			var payload scim.User
+
+			if err := bind(ctx, &payload); err != nil {
+				return fail(ctx, err)
+			}
+
+			var user models.User
+
+			if err := payload.Apply(&user); err != nil {
+				return fail(ctx, err)
+			}
+
+			err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				var existingUser models.User
+
+				err := tx.Where("LOWER(email) = LOWER(?)", user.Email).First(&existingUser).Error
+
+				if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+					return err
+				}
+
+				if err == nil {
+					return scim.NewError(fiber.StatusConflict, scim.ErrorTypeUniqueness, "A user with this userName already exists.")
+				}
+
+				active := user.Active
+
+				user.Type = models.UserTypeOrganizationUser
+				user.EmailVerified = true
+				user.ProvisionedBy = &organization.Id
+
+				if err := tx.Create(&user).Error; err != nil {
+					return err
+				}
+
+				if !active {
+					if err := tx.Model(&user).Update("active", false).Error; err != nil {
+						return err
+					}
+				}
+
+				return tx.Exec(
+					"INSERT INTO organizations_members (organization_id, user_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+					organization.Id,
+					user.Id,
+				).Error
+			})
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			resource, err := userResource(r.storage.Database(), organization.Id, &user)
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			ctx.Set(fiber.HeaderLocation, resource.Meta.Location)
+
+			return respond(ctx, fiber.StatusCreated, resource)
+		},
+	}
+}
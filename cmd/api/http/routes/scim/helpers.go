@@ -0,0 +1,250 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/jwt"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func organization(ctx fiber.Ctx) *models.Organization {
+	return ctx.Locals("organization").(*models.Organization)
+}
+
+func bind(ctx fiber.Ctx, value any) error {
+	if err := json.Unmarshal(ctx.Body(), value); err != nil {
+		return scim.NewError(fiber.StatusBadRequest, scim.ErrorTypeInvalidSyntax, "Invalid request body.")
+	}
+
+	return nil
+}
+
+func parseId(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+
+	if err != nil {
+		return uuid.Nil, gorm.ErrRecordNotFound
+	}
+
+	return parsed, nil
+}
+
+func members(db *gorm.DB, organizationId uuid.UUID) *gorm.DB {
+	return db.Model(&models.User{}).
+		Joins("JOIN organizations_members ON organizations_members.user_id = users.id").
+		Where("organizations_members.organization_id = ?", organizationId)
+}
+
+func groups(db *gorm.DB, organizationId uuid.UUID) *gorm.DB {
+	return db.Model(&models.Role{}).
+		Where("roles.organization_id = ?", organizationId)
+}
+
+func findUser(db *gorm.DB, organizationId uuid.UUID, id string) (*models.User, error) {
+	userId, err := parseId(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+
+	if err := members(db, organizationId).
+		Where("users.id = ?", userId).
+		First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func findGroup(db *gorm.DB, organizationId uuid.UUID, id string) (*models.Role, error) {
+	roleId, err := parseId(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var role models.Role
+
+	if err := groups(db, organizationId).
+		Where("roles.id = ?", roleId).
+		First(&role).Error; err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func userResource(db *gorm.DB, organizationId uuid.UUID, user *models.User) (scim.User, error) {
+	var userGroups []models.Role
+
+	if err := groups(db, organizationId).
+		Joins("JOIN users_roles ON users_roles.role_id = roles.id").
+		Where("users_roles.user_id = ?", user.Id).
+		Order("roles.name ASC").
+		Find(&userGroups).Error; err != nil {
+		return scim.User{}, err
+	}
+
+	return scim.FromUser(user, userGroups, baseUrl()), nil
+}
+
+func groupResource(db *gorm.DB, organizationId uuid.UUID, role *models.Role) (scim.Group, error) {
+	var groupMembers []models.User
+
+	if err := members(db, organizationId).
+		Joins("JOIN users_roles ON users_roles.user_id = users.id").
+		Where("users_roles.role_id = ?", role.Id).
+		Order("users.name ASC").
+		Find(&groupMembers).Error; err != nil {
+		return scim.Group{}, err
+	}
+
+	return scim.FromGroup(role, groupMembers, baseUrl()), nil
+}
+
+func managed(user *models.User) error {
+	if user.Type == models.UserTypeSystemAdmin || user.Type == models.UserTypeSystemUser {
+		return scim.NewError(fiber.StatusForbidden, scim.ErrorTypeMutability, "System accounts cannot be managed through SCIM.")
+	}
+
+	return nil
+}
+
+func provisioned(user *models.User, organizationId uuid.UUID) error {
+	if user.ProvisionedBy == nil || *user.ProvisionedBy != organizationId {
+		return scim.NewError(fiber.StatusForbidden, scim.ErrorTypeMutability, "Only users provisioned by this organization can be changed through SCIM.")
+	}
+
+	return nil
+}
+
+func emailTaken(db *gorm.DB, email string, exceptId uuid.UUID) error {
+	var count int64
+
+	if err := db.Model(&models.User{}).
+		Where("LOWER(email) = LOWER(?) AND id <> ?", strings.TrimSpace(email), exceptId).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return scim.NewError(fiber.StatusConflict, scim.ErrorTypeUniqueness, "A user with this userName already exists.")
+	}
+
+	return nil
+}
+
+func saveUser(tx *gorm.DB, user *models.User) error {
+	return tx.Model(user).
+		Select("name", "email", "external_id", "active").
+		Updates(user).Error
+}
+
+func deactivated(storage storage.Storage, user *models.User) error {
+	if err := sessions.RevokeAll(storage, user.Id); err != nil {
+		return err
+	}
+
+	if err := jwt.RevokeUser(storage, user.Id); err != nil {
+		return err
+	}
+
+	return storage.Database().
+		Where("user_id = ?", user.Id).
+		Delete(&models.ApiToken{}).Error
+}
+
+func memberIds(db *gorm.DB, organizationId uuid.UUID, ids []string) ([]uuid.UUID, error) {
+	userIds := []uuid.UUID{}
+
+	for _, id := range ids {
+		userId, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, scim.NewError(fiber.StatusBadRequest, scim.ErrorTypeInvalidValue, "Member \""+id+"\" is not a valid user id.")
+		}
+
+		userIds = append(userIds, userId)
+	}
+
+	if len(userIds) == 0 {
+		return userIds, nil
+	}
+
+	var found []uuid.UUID
+
+	if err := members(db, organizationId).
+		Where("users.id IN ?", userIds).
+		Pluck("users.id", &found).Error; err != nil {
+		return nil, err
+	}
+
+	if len(found) != len(uniqueIds(userIds)) {
+		return nil, scim.NewError(fiber.StatusBadRequest, scim.ErrorTypeInvalidValue, "Every group member must be a user of this organization.")
+	}
+
+	return found, nil
+}
+
+func uniqueIds(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	unique := map[uuid.UUID]struct{}{}
+
+	for _, id := range ids {
+		unique[id] = struct{}{}
+	}
+
+	return unique
+}
+
+func addMembers(tx *gorm.DB, roleId uuid.UUID, userIds []uuid.UUID) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	return tx.Exec(
+		"INSERT INTO users_roles (user_id, role_id) SELECT id, ? FROM users WHERE id IN ? ON CONFLICT DO NOTHING",
+		roleId,
+		userIds,
+	).Error
+}
+
+func removeMembers(tx *gorm.DB, organizationId uuid.UUID, roleId uuid.UUID, userIds []uuid.UUID) error {
+	query := "DELETE FROM users_roles WHERE role_id = ? AND user_id IN (SELECT user_id FROM organizations_members WHERE organization_id = ?)"
+	args := []any{roleId, organizationId}
+
+	if userIds != nil {
+		if len(userIds) == 0 {
+			return nil
+		}
+
+		query += " AND user_id IN ?"
+		args = append(args, userIds)
+	}
+
+	return tx.Exec(query, args...).Error
+}
+
+func groupNameTaken(db *gorm.DB, name string, exceptId uuid.UUID) error {
+	var count int64
+
+	if err := db.Model(&models.Role{}).
+		Where("LOWER(name) = LOWER(?) AND id <> ?", strings.TrimSpace(name), exceptId).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return scim.NewError(fiber.StatusConflict, scim.ErrorTypeUniqueness, "A group with this displayName already exists.")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package scim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestExistingUsers(t *testing.T) {
+	organizationId := uuid.New()
+	otherOrganizationId := uuid.New()
+
+	tests := []struct {
+		name     string
+		user     models.User
+		editable bool
+	}{
+		{"provisioned by this organization", models.User{Type: models.UserTypeOrganizationUser, ProvisionedBy: &organizationId}, true},
+		{"provisioned by another organization", models.User{Type: models.UserTypeOrganizationUser, ProvisionedBy: &otherOrganizationId}, false},
+		{"joined through an invitation", models.User{Type: models.UserTypeOrganizationUser}, false},
+		{"organization owner", models.User{Type: models.UserTypeOrganizationOwner}, false},
+		{"system admin", models.User{Type: models.UserTypeSystemAdmin, ProvisionedBy: &organizationId}, false},
+		{"system user", models.User{Type: models.UserTypeSystemUser, ProvisionedBy: &organizationId}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := managed(&test.user)
+
+			if err == nil {
+				err = provisioned(&test.user, organizationId)
+			}
+
+			if test.editable {
+				if err != nil {
+					t.Fatalf("error = %v, want the user to be editable", err)
+				}
+
+				return
+			}
+
+			var scimError *scim.ScimError
+
+			if !errors.As(err, &scimError) || scimError.Status != fiber.StatusForbidden || scimError.ScimType != scim.ErrorTypeMutability {
+				t.Errorf("error = %v, want a 403 mutability error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package scim
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *ScimRouter) ListUsersRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The organization's users have been returned.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "List SCIM Users",
+			Description: "Lists the users of the token's organization, optionally narrowed with a SCIM filter such as userName eq \"jane@example.com\".",
+			Tags:        []string{"SCIM"},
+			Parameters:  listParameters(),
+			RequestBody: nil,
+			Responses:   responses,
+		},
+		Method: routing.GET,
+		Path:   "/scim/v2/Users",
+		Middlewares: []fiber.Handler{
+			r.middleware.Provisioned(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			organization := organization(ctx)
+			startIndex, count := pagination(ctx)
+
+			query := members(r.storage.Database(), organization.Id)
+
+			if filter := ctx.Query("filter"); filter != "" {
+				parsed, err := scim.ParseFilter(filter, scim.UserAttributes)
+
+				if err != nil {
+					return fail(ctx, err)
+				}
+
+				query = query.Where(parsed.Query, parsed.Args...)
+			}
+
+			var totalResults int64
+
+			if err := query.Count(&totalResults).Error; err != nil {
+				return fail(ctx, err)
+			}
+
+			var users []models.User
+
+			if err := query.
+				Order("users.created_at ASC").
+				Offset(startIndex - 1).
+				Limit(count).
+				Find(&users).Error; err != nil {
+				return fail(ctx, err)
+			}
+
+			resources := []any{}
+
+			for _, user := range users {
+				resource, err := userResource(r.storage.Database(), organization.Id, &user)
+
+				if err != nil {
+					return fail(ctx, err)
+				}
+
+				resources = append(resources, resource)
+			}
+
+			return respond(ctx, fiber.StatusOK, scim.NewListResponse(totalResults, startIndex, resources))
+		},
+	}
+}
+
+func listParameters() []*openapi3.ParameterRef {
+	return []*openapi3.ParameterRef{
+		{
+			Value: openapi3.NewQueryParameter("filter").
+				WithDescription("A SCIM filter expression.").
+				WithSchema(openapi3.NewStringSchema()),
+		},
+		{
+			Value: openapi3.NewQueryParameter("startIndex").
+				WithDescription("The 1-based index of the first result.").
+				WithSchema(openapi3.NewIntegerSchema()),
+		},
+		{
+			Value: openapi3.NewQueryParameter("count").
+				WithDescription("The maximum number of results to return.").
+				WithSchema(openapi3.NewIntegerSchema()),
+		},
+	}
+}
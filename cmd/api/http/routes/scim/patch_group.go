@@ -0,0 +1,194 @@
+package scim
+
+import (
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (r *ScimRouter) PatchGroupRoute() routing.Route {
+	responses := openapi3.NewResponses()
+
+	responses.Set("200", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithDescription("The group has been updated.").
+			WithContent(openapi3.Content{
+				"text/plain": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/SuccessResponse",
+					}),
+			}),
+	})
+
+	responses.Set("400", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Bad Request").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("401", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Unauthorized").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("403", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Forbidden").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("404", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Not Found").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	responses.Set("500", &openapi3.ResponseRef{
+		Value: openapi3.NewResponse().
+			WithJSONSchemaRef(&openapi3.SchemaRef{
+				Ref: "#/components/schemas/ErrorResponse",
+			}).
+			WithDescription("Internal Server Error").
+			WithContent(openapi3.Content{
+				"application/json": openapi3.NewMediaType().
+					WithSchemaRef(&openapi3.SchemaRef{
+						Ref: "#/components/schemas/ErrorResponse",
+					}),
+			}),
+	})
+
+	return routing.Route{
+		OpenAPIMetadata: routing.OpenAPIMetadata{
+			Summary:     "Patch SCIM Group",
+			Description: "Applies SCIM PATCH operations to a group, such as renaming it or adding and removing members.",
+			Tags:        []string{"SCIM"},
+			Parameters: []*openapi3.ParameterRef{
+				{
+					Ref: "#/components/parameters/Id",
+				},
+			},
+			RequestBody: &openapi3.RequestBodyRef{
+				Ref: "#/components/requestBodies/ScimPatchPayload",
+			},
+			Responses: responses,
+		},
+		Method: routing.PATCH,
+		Path:   "/scim/v2/Groups/{id}",
+		Middlewares: []fiber.Handler{
+			r.middleware.Provisioned(),
+		},
+		Handler: func(ctx fiber.Ctx) error {
+			organization := organization(ctx)
+
+			var payload scim.PatchRequest
+
+			if err := bind(ctx, &payload); err != nil {
+				return fail(ctx, err)
+			}
+
+			patch, err := scim.ParseGroupPatch(payload.Operations)
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			role, err := findGroup(r.storage.Database(), organization.Id, ctx.Params("id"))
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			if err := r.storage.Database().Transaction(func(tx *gorm.DB) error {
+				if patch.DisplayName != nil {
+					role.Name = strings.TrimSpace(*patch.DisplayName)
+
+					if role.Name == "" {
+						return scim.NewError(fiber.StatusBadRequest, scim.ErrorTypeInvalidValue, "displayName cannot be empty.")
+					}
+
+					if err := groupNameTaken(tx, role.Name, role.Id); err != nil {
+						return err
+					}
+
+					if err := tx.Model(role).Update("name", role.Name).Error; err != nil {
+						return err
+					}
+				}
+
+				if patch.RemoveAll || patch.Replace != nil {
+					if err := removeMembers(tx, organization.Id, role.Id, nil); err != nil {
+						return err
+					}
+				}
+
+				added, err := memberIds(tx, organization.Id, append(patch.Replace, patch.Add...))
+
+				if err != nil {
+					return err
+				}
+
+				if err := addMembers(tx, role.Id, added); err != nil {
+					return err
+				}
+
+				removed := []uuid.UUID{}
+
+				for _, id := range patch.Remove {
+					if userId, err := uuid.Parse(id); err == nil {
+						removed = append(removed, userId)
+					}
+				}
+
+				return removeMembers(tx, organization.Id, role.Id, removed)
+			}); err != nil {
+				return fail(ctx, err)
+			}
+
+			resource, err := groupResource(r.storage.Database(), organization.Id, role)
+
+			if err != nil {
+				return fail(ctx, err)
+			}
+
+			return respond(ctx, fiber.StatusOK, resource)
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/scim"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+type ScimRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func New(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &ScimRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *ScimRouter) LoadRoutes() []routing.Route {
+	return []routing.Route{
+		r.ServiceProviderConfigRoute(),
+
+		r.ListUsersRoute(),
+		r.GetUserRoute(),
+		r.CreateUserRoute(),
+		r.ReplaceUserRoute(),
+		r.PatchUserRoute(),
+		r.DeleteUserRoute(),
+
+		r.ListGroupsRoute(),
+		r.GetGroupRoute(),
+		r.CreateGroupRoute(),
+		r.ReplaceGroupRoute(),
+		r.PatchGroupRoute(),
+		r.DeleteGroupRoute(),
+	}
+}
+
+func baseUrl() string {
+	apiBaseUrl := fmt.Sprintf("http://localhost:%s", common.EnvString("API_PORT", "6173"))
+
+	if common.EnvString("API_MODE", "development") == "production" {
+		apiBaseUrl = common.EnvString("API_BASE_URL", "https://example.com")
+	}
+
+	return apiBaseUrl + "/api/v1/scim/v2"
+}
+
+func maxResults() int {
+	return common.EnvInt("API_SCIM_MAX_RESULTS", 200)
+}
+
+func pagination(ctx fiber.Ctx) (int, int) {
+	startIndex, err := strconv.Atoi(ctx.Query("startIndex", "1"))
+
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(ctx.Query("count", strconv.Itoa(maxResults())))
+
+	if err != nil || count < 0 {
+		count = maxResults()
+	}
+
+	return startIndex, min(count, maxResults())
+}
+
+func respond(ctx fiber.Ctx, status int, body any) error {
+	return ctx.Status(status).JSON(body, scim.ContentType)
+}
+
+func fail(ctx fiber.Ctx, err error) error {
+	var scimError *scim.ScimError
+
+	if errors.As(err, &scimError) {
+		return respond(ctx, scimError.Status, scimError.Body())
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return respond(ctx, fiber.StatusNotFound, scim.NewError(fiber.StatusNotFound, "", "Resource not found.").Body())
+	}
+
+	log.Errorf("🔥 Failed to process SCIM request: %s", err.Error())
+
+	return respond(ctx, fiber.StatusInternalServerError, scim.NewError(fiber.StatusInternalServerError, "", "An error occurred while processing your request.").Body())
+}
@@ -0,0 +1,51 @@
+package security
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/routes"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/baseApi"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+)
+
+type SecurityRouter struct {
+	storage    storage.Storage
+	middleware middleware.Middleware
+}
+
+func New(storage storage.Storage, middleware middleware.Middleware) routes.Router {
+	return &SecurityRouter{
+		storage:    storage,
+		middleware: middleware,
+	}
+}
+
+func (r *SecurityRouter) LoadRoutes() []routing.Route {
+	lockoutEventsApi := baseApi.New[models.LockoutEvent](
+		r.storage,
+		"/security/lockouts",
+		"LockoutEvent",
+	)
+
+	impersonationEventsApi := baseApi.New[models.ImpersonationEvent](
+		r.storage,
+		"/security/impersonations",
+		"ImpersonationEvent",
+	)
+
+	return []routing.Route{
+		lockoutEventsApi.GetAllRoute(
+			r.middleware.Authenticated(),
+		).WithPermissions("security.lockouts.list"),
+		lockoutEventsApi.GetOneRoute(
+			r.middleware.Authenticated(),
+		).WithPermissions("security.lockouts.view"),
+		impersonationEventsApi.GetAllRoute(
+			r.middleware.Authenticated(),
+		).WithPermissions("security.impersonations.list"),
+		impersonationEventsApi.GetOneRoute(
+			r.middleware.Authenticated(),
+		).WithPermissions("security.impersonations.view"),
+	}
+}
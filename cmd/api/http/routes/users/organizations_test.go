@@ -0,0 +1,101 @@
+package users
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+func TestOrganizationAssignmentPolicies(t *testing.T) {
+	userId := uuid.New()
+	organizationId := uuid.New()
+
+	tests := []struct {
+		name     string
+		userType models.UserType
+		owner    bool
+		member   bool
+		status   int
+	}{
+		{"owner", models.UserTypeOrganizationOwner, true, false, fiber.StatusOK},
+		{"member", models.UserTypeOrganizationUser, false, true, fiber.StatusOK},
+		{"outsider", models.UserTypeOrganizationOwner, false, false, fiber.StatusForbidden},
+		{"system user outsider", models.UserTypeSystemUser, false, false, fiber.StatusForbidden},
+		{"system admin", models.UserTypeSystemAdmin, false, false, fiber.StatusOK},
+	}
+
+	paths := []string{
+		"/users/assign-organization/{userId}/{organizationId}",
+		"/users/unassign-organization/{userId}/{organizationId}",
+	}
+
+	for _, path := range paths {
+		for _, test := range tests {
+			t.Run(path+"/"+test.name, func(t *testing.T) {
+				// Only the organization resolves, so the policies pass only
+				// when they are checked against it rather than the user.
+				storage := testutil.Fake(t, &testutil.Database{
+					Query: func(statement testutil.Statement) *testutil.Rows {
+						switch {
+						case statement.Contains("count(*)"):
+							if test.owner || (test.member && statement.Contains("organizations_members")) {
+								return testutil.Count(1)
+							}
+
+							return testutil.Count(0)
+						case statement.Contains(`FROM "organizations"`):
+							return testutil.Row(map[string]any{"id": organizationId.String()})
+						}
+
+						return nil
+					},
+				})
+				router := &UsersRouter{storage: storage, middleware: middleware.New(storage)}
+
+				for _, route := range router.LoadRoutes() {
+					if route.Path != path {
+						continue
+					}
+
+					app := fiber.New()
+					app.Use(session.New())
+
+					// Skip Authenticated, which needs a logged in session.
+					handlers := append([]fiber.Handler{route.Middlewares[0]}, route.Middlewares[2:]...)
+					handlers = append(handlers, func(ctx fiber.Ctx) error {
+						return ctx.SendStatus(fiber.StatusOK)
+					})
+
+					app.Post(strings.NewReplacer("{", ":", "}", "").Replace(path), func(ctx fiber.Ctx) error {
+						ctx.Locals("user", &models.User{Base: models.Base{Id: uuid.New()}, Type: test.userType})
+
+						return ctx.Next()
+					}, handlers...)
+
+					target := strings.NewReplacer("{userId}", userId.String(), "{organizationId}", organizationId.String()).Replace(path)
+
+					response, err := app.Test(httptest.NewRequest(fiber.MethodPost, target, nil))
+
+					if err != nil {
+						t.Fatalf("request failed: %v", err)
+					}
+
+					if response.StatusCode != test.status {
+						t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+					}
+
+					return
+				}
+
+				t.Fatalf("route %s is not registered", path)
+			})
+		}
+	}
+}
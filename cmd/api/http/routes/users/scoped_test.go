@@ -0,0 +1,95 @@
+package users
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+// newTargetStorage answers the target user lookup, its organizations and
+// whether the caller belongs to one of them.
+func newTargetStorage(t *testing.T, targetId uuid.UUID, shared bool) *testutil.Storage {
+	t.Helper()
+
+	organizationId := uuid.New()
+
+	return testutil.Fake(t, &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			switch {
+			case statement.Contains("count(*)"):
+				if shared {
+					return testutil.Count(1)
+				}
+
+				return testutil.Count(0)
+			case statement.Contains(`SELECT "id" FROM "organizations"`):
+				return testutil.Column("id", organizationId.String())
+			case statement.Contains(`FROM "users"`):
+				return testutil.Row(map[string]any{"id": targetId.String()})
+			}
+
+			return nil
+		},
+	})
+}
+
+func TestTargetScoping(t *testing.T) {
+	targetId := uuid.New()
+
+	routes := map[string]func(r *UsersRouter) routing.Route{
+		"revoke sessions": (*UsersRouter).RevokeSessionsRoute,
+		"unlock":          (*UsersRouter).UnlockRoute,
+		"update type":     (*UsersRouter).UpdateTypeRoute,
+	}
+
+	tests := []struct {
+		name     string
+		userType models.UserType
+		shared   bool
+		status   int
+	}{
+		{"same organization", models.UserTypeOrganizationOwner, true, fiber.StatusOK},
+		{"other organization", models.UserTypeOrganizationOwner, false, fiber.StatusForbidden},
+		{"system user in another organization", models.UserTypeSystemUser, false, fiber.StatusForbidden},
+		{"system admin", models.UserTypeSystemAdmin, false, fiber.StatusOK},
+	}
+
+	for routeName, load := range routes {
+		for _, test := range tests {
+			t.Run(routeName+"/"+test.name, func(t *testing.T) {
+				storage := newTargetStorage(t, targetId, test.shared)
+				route := load(&UsersRouter{storage: storage, middleware: middleware.New(storage)})
+
+				app := fiber.New()
+				app.Use(session.New())
+
+				handlers := append(route.Middlewares[1:], func(ctx fiber.Ctx) error {
+					return ctx.SendStatus(fiber.StatusOK)
+				})
+
+				app.Add([]string{string(route.Method)}, "/users/:id/*", func(ctx fiber.Ctx) error {
+					ctx.Locals("user", &models.User{Base: models.Base{Id: uuid.New()}, Type: test.userType})
+
+					return ctx.Next()
+				}, handlers...)
+
+				response, err := app.Test(httptest.NewRequest(string(route.Method), "/users/"+targetId.String()+"/action", nil))
+
+				if err != nil {
+					t.Fatalf("request failed: %v", err)
+				}
+
+				if response.StatusCode != test.status {
+					t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+				}
+			})
+		}
+	}
+}
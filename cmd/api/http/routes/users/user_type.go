@@ -0,0 +1,76 @@
+package users
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/go-openapi/inflect"
+	"github.com/gofiber/fiber/v3"
+)
+
+func typeRejected(ctx fiber.Ctx, currentUser *models.User, current models.UserType, requested models.UserType) (bool, error) {
+	if !requested.Valid() {
+		return true, ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Bad Request",
+			"message": "Please provide a valid user type.",
+		})
+	}
+
+	if (current.System() || requested.System()) && currentUser.Type != models.UserTypeSystemAdmin {
+		return true, ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "Forbidden",
+			"message": "Only system administrators can assign or remove system user types.",
+		})
+	}
+
+	return false, nil
+}
+
+func (r *UsersRouter) guardType() fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		var payload map[string]any
+
+		if err := ctx.Bind().Body(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Bad Request",
+				"message": "Invalid request body.",
+			})
+		}
+
+		requested := []string{}
+
+		for key, value := range payload {
+			if strings.EqualFold(key, "type") || inflect.Underscore(key) == "type" {
+				userType, _ := value.(string)
+				requested = append(requested, userType)
+			}
+		}
+
+		if len(requested) == 0 {
+			payload["type"] = common.EnvString("API_DEFAULT_USER_TYPE", string(models.UserTypeOrganizationUser))
+
+			body, err := json.Marshal(payload)
+
+			if err != nil {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "Bad Request",
+					"message": "Invalid request body.",
+				})
+			}
+
+			ctx.Request().SetBody(body)
+
+			return ctx.Next()
+		}
+
+		for _, userType := range requested {
+			if rejected, err := typeRejected(ctx, ctx.Locals("user").(*models.User), "", models.UserType(userType)); rejected {
+				return err
+			}
+		}
+
+		return ctx.Next()
+	}
+}
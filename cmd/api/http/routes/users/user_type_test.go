@@ -0,0 +1,109 @@
+package users
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestTypeRejected(t *testing.T) {
+	systemAdmin := &models.User{Type: models.UserTypeSystemAdmin}
+	organizationOwner := &models.User{Type: models.UserTypeOrganizationOwner}
+
+	tests := []struct {
+		name      string
+		caller    *models.User
+		current   models.UserType
+		requested models.UserType
+		status    int
+	}{
+		{"organization types", organizationOwner, models.UserTypeOrganizationUser, models.UserTypeOrganizationOwner, fiber.StatusOK},
+		{"invalid type", systemAdmin, models.UserTypeOrganizationUser, "superuser", fiber.StatusBadRequest},
+		{"assign a system type", organizationOwner, models.UserTypeOrganizationUser, models.UserTypeSystemUser, fiber.StatusForbidden},
+		{"remove a system type", organizationOwner, models.UserTypeSystemAdmin, models.UserTypeOrganizationUser, fiber.StatusForbidden},
+		{"system admin assigns a system type", systemAdmin, models.UserTypeOrganizationUser, models.UserTypeSystemAdmin, fiber.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+
+			app.Post("/", func(ctx fiber.Ctx) error {
+				if rejected, err := typeRejected(ctx, test.caller, test.current, test.requested); rejected {
+					return err
+				}
+
+				return ctx.SendStatus(fiber.StatusOK)
+			})
+
+			response, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/", nil))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
+
+func TestGuardType(t *testing.T) {
+	t.Setenv("API_DEFAULT_USER_TYPE", string(models.UserTypeOrganizationUser))
+
+	systemAdmin := &models.User{Type: models.UserTypeSystemAdmin}
+	organizationOwner := &models.User{Type: models.UserTypeOrganizationOwner}
+
+	tests := []struct {
+		name   string
+		caller *models.User
+		body   string
+		status int
+		want   string
+	}{
+		{"default type", organizationOwner, `{"name":"Jane"}`, fiber.StatusOK, `"type":"organization_user"`},
+		{"organization type", organizationOwner, `{"name":"Jane","type":"organization_owner"}`, fiber.StatusOK, `"type":"organization_owner"`},
+		{"system type", organizationOwner, `{"name":"Jane","type":"system_admin"}`, fiber.StatusForbidden, ""},
+		{"system type with another casing", organizationOwner, `{"name":"Jane","Type":"system_user"}`, fiber.StatusForbidden, ""},
+		{"invalid type", organizationOwner, `{"name":"Jane","type":"superuser"}`, fiber.StatusBadRequest, ""},
+		{"system admin assigns a system type", systemAdmin, `{"name":"Jane","type":"system_user"}`, fiber.StatusOK, `"type":"system_user"`},
+		{"malformed body", organizationOwner, `[`, fiber.StatusBadRequest, ""},
+	}
+
+	router := &UsersRouter{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+
+			app.Post("/users", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", test.caller)
+
+				return ctx.Next()
+			}, router.guardType(), func(ctx fiber.Ctx) error {
+				if !strings.Contains(string(ctx.Body()), test.want) {
+					t.Errorf("body = %s, want it to contain %s", ctx.Body(), test.want)
+				}
+
+				return ctx.SendStatus(fiber.StatusOK)
+			})
+
+			request := httptest.NewRequest(fiber.MethodPost, "/users", strings.NewReader(test.body))
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err := app.Test(request)
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/assignApi"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/baseApi"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 )
@@ -39,59 +40,71 @@ func (r *UsersRouter) LoadRoutes() []routing.Route {
 		r.storage,
 		"/users",
 		"User",
+		baseApi.WithTenancy(
+			baseApi.OrganizationJoin("organizations_members", "user_id", "organization_id"),
+			baseApi.OrganizationJoin("organizations", "owner_id", "id"),
+		),
+		baseApi.WithUpdatable("name", "bio"),
 	)
 
 	routes := []routing.Route{}
 
 	routes = append(routes, []routing.Route{
+		r.RevokeSessionsRoute(),
+		r.UnlockRoute(),
+		r.UpdateTypeRoute(),
+
 		userOrganizationAssignmentApi.AssignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.organizations.assign"),
-		),
+			policies.Resolve[models.Organization](r.storage, "organizationId"),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+		).WithPermissions("users.organizations.assign"),
 		userOrganizationAssignmentApi.UnassignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.organizations.unassign"),
-		),
+			policies.Resolve[models.Organization](r.storage, "organizationId"),
+			r.middleware.Policies(
+				models.PolicySystemAdmin,
+				models.PolicyOrganizationOwner,
+				models.PolicySameOrganization,
+			),
+		).WithPermissions("users.organizations.unassign"),
 		userOrganizationAssignmentApi.ListRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.organizations.list"),
-		),
+		).WithPermissions("users.organizations.list"),
 
 		userRoleAssignmentApi.AssignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.roles.assign"),
-		),
+			r.middleware.Grantable("roleId"),
+		).WithPermissions("users.roles.assign"),
 		userRoleAssignmentApi.UnassignRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.roles.unassign"),
-		),
+		).WithPermissions("users.roles.unassign"),
 		userRoleAssignmentApi.ListRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.roles.list"),
-		),
+		).WithPermissions("users.roles.list"),
 
 		usersApi.GetAllRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.list"),
-		),
+		).WithPermissions("users.list"),
 		usersApi.GetOneRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.view"),
-		),
+		).WithPermissions("users.view"),
 		usersApi.CreateRoute(
 			"#/components/requestBodies/CreateUserPayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.create"),
-		),
+			r.guardType(),
+		).WithPermissions("users.create"),
 		usersApi.UpdateRoute(
 			"#/components/requestBodies/UpdateUserPayload",
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.update"),
-		),
+		).WithPermissions("users.update"),
 		usersApi.DeleteRoute(
 			r.middleware.Authenticated(),
-			r.middleware.Authorized("users.delete"),
-		),
+		).WithPermissions("users.delete"),
 	}...)
 
 	return routes
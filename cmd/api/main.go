@@ -8,9 +8,10 @@ import (
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http"
 	"github.com/connor-davis/dialogue-video-analysis-tool/cmd/api/http/middleware"
 	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/authenticator"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/mail"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 	"github.com/goccy/go-json"
-	"github.com/gofiber/contrib/v3/websocket"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/extractors"
 	"github.com/gofiber/fiber/v3/log"
@@ -29,14 +30,21 @@ func main() {
 		log.Errorf("🔥 Failed to migrate models: %s", err.Error())
 	}
 
+	if err := authenticator.Backfill(storage); err != nil {
+		log.Errorf("🔥 Failed to backfill TOTP parameters: %s", err.Error())
+	}
+
 	openai := openai.NewClient(
 		option.WithAPIKey(common.EnvString("OPENAI_API_KEY", "sk...")), // or set OPENAI_API_KEY in your env
 	)
 
+	mail := mail.New()
+
 	middleware := middleware.New(storage)
 
 	app := fiber.New(fiber.Config{
 		AppName:       "One REST API",
+		BodyLimit:     common.EnvInt("API_MAX_BODY_LIMIT", 4*1024*1024),
 		ServerHeader:  "One REST API",
 		JSONEncoder:   json.Marshal,
 		JSONDecoder:   json.Unmarshal,
@@ -45,23 +53,20 @@ func main() {
 	})
 
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: []string{
-			common.EnvString(
-				"APP_BASE_URL",
-				"http://localhost:3000",
-			),
-			"http://localhost:3000",
-		},
+		AllowOrigins:     common.AllowedOrigins(),
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowCredentials: true,
+		ExposeHeaders:    []string{"X-Impersonated-By"},
 	}))
 
+	app.Use(middleware.SecurityHeaders("/api/v1/api-docs"))
+
 	app.Use(logger.New(logger.Config{
 		TimeFormat: "2006-01-01 00:00:00",
 		TimeZone:   "Africa/Johannesburg",
 	}))
 
-	app.Use(session.New(session.Config{
+	sessionHandler, sessionStore := session.NewWithStore(session.Config{
 		Storage: postgres.New(postgres.Config{
 			Table:         "sessions",
 			ConnectionURI: common.EnvString("DATABASE_DSN", "host=localhost user=<user> password=<password> dbname=<database> port=5432 sslmode=disable TimeZone=Africa/Johannesburg"),
@@ -71,25 +76,25 @@ func main() {
 		CookieSameSite:    "Lax",
 		CookieSecure:      true,
 		CookieSessionOnly: false,
-		CookieHTTPOnly:    false,
+		CookieHTTPOnly:    true,
 		Extractor:         extractors.FromCookie(common.EnvString("API_SESSION_COOKIE", "one_session")),
 		IdleTimeout:       1 * time.Hour,
 		AbsoluteTimeout:   1 * time.Hour,
-	}))
-
-	apiv1 := app.Group("/api/v1")
+	})
 
-	apiv1.Use("/ws", func(ctx fiber.Ctx) error {
-		if websocket.IsWebSocketUpgrade(ctx) {
-			ctx.Locals("ws-allowed", true)
+	app.Use(sessionHandler)
+	app.Use(middleware.Csrf(
+		sessionStore,
+		"/api/v1/authentication/token/password",
+		"/api/v1/authentication/token/refresh",
+		"/api/v1/authentication/token/revoke",
+	))
 
-			return ctx.Next()
-		}
+	apiv1 := app.Group("/api/v1")
 
-		return fiber.ErrUpgradeRequired
-	})
+	apiv1.Use("/ws", middleware.WebSocketOrigin())
 
-	httpRouter := http.New(storage, middleware, openai)
+	httpRouter := http.New(storage, middleware, mail, openai)
 	httpRouter.InitializeRoutes(apiv1)
 
 	openapi := httpRouter.InitializeOpenAPI()
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/secrets"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+func main() {
+	storage := storage.New()
+
+	keyring, err := secrets.Default()
+
+	if err != nil {
+		log.Errorf("🔥 Failed to load encryption keys: %s", err.Error())
+
+		os.Exit(1)
+	}
+
+	for _, column := range secrets.Columns {
+		rotated, err := secrets.Rotate(storage, keyring, column)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to re-encrypt %s.%s after %d values: %s", column.Table, column.Column, rotated, err.Error())
+
+			os.Exit(1)
+		}
+
+		log.Infof("✅ Re-encrypted %d values in %s.%s.", rotated, column.Table, column.Column)
+	}
+}
@@ -2,6 +2,8 @@ package common
 
 import (
 	"os"
+	"strconv"
+	"time"
 
 	_ "github.com/joho/godotenv/autoload"
 )
@@ -13,3 +15,23 @@ func EnvString(key, fallback string) string {
 
 	return fallback
 }
+
+func EnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+
+	return fallback
+}
+
+func EnvInt(key string, fallback int) int {
+	if value, exists := os.LookupEnv(key); exists {
+		if number, err := strconv.Atoi(value); err == nil {
+			return number
+		}
+	}
+
+	return fallback
+}
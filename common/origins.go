@@ -0,0 +1,23 @@
+package common
+
+import (
+	"slices"
+	"strings"
+)
+
+func AllowedOrigins() []string {
+	origins := []string{}
+
+	for _, origin := range []string{
+		EnvString("APP_BASE_URL", "http://localhost:3000"),
+		"http://localhost:3000",
+	} {
+		origin = strings.TrimRight(origin, "/")
+
+		if !slices.Contains(origins, origin) {
+			origins = append(origins, origin)
+		}
+	}
+
+	return origins
+}
@@ -74,7 +74,7 @@ export const Route = createFileRoute('/_auth/roles/')({
         pageSize,
         preload: [],
         searchTerm,
-        searchColumn: ['name', 'rolename'],
+        searchColumn: ['name', 'description'],
       },
       throwOnError: true,
     });
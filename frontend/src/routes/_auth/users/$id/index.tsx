@@ -83,7 +83,10 @@ function RouteComponent() {
                 path: {
                   id,
                 },
-                body: values,
+                body: {
+                  name: values.name,
+                  bio: values.bio,
+                },
               }),
               {
                 loading: 'Updating your profile...',
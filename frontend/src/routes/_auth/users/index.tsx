@@ -70,7 +70,7 @@ export const Route = createFileRoute('/_auth/users/')({
         pageSize,
         preload: [],
         searchTerm,
-        searchColumn: ['name', 'username'],
+        searchColumn: ['name', 'email'],
       },
       throwOnError: true,
     });
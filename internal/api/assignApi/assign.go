@@ -136,7 +136,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) AssignRoute(middleware ...fib
 		strings.ToLower(inflect.Dasherize(a.childName)),
 		inflect.Parameterize(a.parentName),
 		inflect.Parameterize(a.childName),
-	), Middlewares: middleware, Handler: func(ctx fiber.Ctx) error {
+	), Middlewares: append([]fiber.Handler{a.target()}, middleware...), Handler: func(ctx fiber.Ctx) error {
 		parentId := ctx.Params(fmt.Sprintf(
 			"%sId",
 			inflect.Parameterize(a.parentName),
@@ -197,10 +197,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) AssignRoute(middleware ...fib
 
 		if err := a.storage.Database().
 			Model(&parentEntity).
-			Association(fmt.Sprintf(
-				"%s",
-				inflect.Pluralize(a.childName),
-			)).
+			Association(a.association).
 			Find(&existingAssociation, "id = ?", childId); err != nil {
 			return ctx.Status(fiber.StatusInternalServerError).
 				JSON(fiber.Map{
@@ -223,10 +220,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) AssignRoute(middleware ...fib
 
 		if err := a.storage.Database().
 			Model(&parentEntity).
-			Association(fmt.Sprintf(
-				"%s",
-				inflect.Pluralize(a.childName),
-			)).
+			Association(a.association).
 			Append(&childEntity); err != nil {
 			return ctx.Status(fiber.StatusInternalServerError).
 				JSON(fiber.Map{
@@ -1,9 +1,17 @@
 package assignApi
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/go-openapi/inflect"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
 )
 
 type AssignmentApi[ParentEntity any, ChildEntity any] interface {
@@ -14,17 +22,48 @@ type AssignmentApi[ParentEntity any, ChildEntity any] interface {
 }
 
 type assignmentApi[ParentEntity any, ChildEntity any] struct {
-	storage    storage.Storage
-	baseUrl    string
-	parentName string
-	childName  string
+	storage     storage.Storage
+	baseUrl     string
+	parentName  string
+	childName   string
+	association string
 }
 
 func New[ParentEntity any, ChildEntity any](storage storage.Storage, baseUrl string, parentName string, childName string) AssignmentApi[ParentEntity, ChildEntity] {
+	return NewWithAssociation[ParentEntity, ChildEntity](storage, baseUrl, parentName, childName, inflect.Pluralize(childName))
+}
+
+func NewWithAssociation[ParentEntity any, ChildEntity any](storage storage.Storage, baseUrl string, parentName string, childName string, association string) AssignmentApi[ParentEntity, ChildEntity] {
 	return &assignmentApi[ParentEntity, ChildEntity]{
-		storage:    storage,
-		baseUrl:    baseUrl,
-		parentName: parentName,
-		childName:  childName,
+		storage:     storage,
+		baseUrl:     baseUrl,
+		parentName:  parentName,
+		childName:   childName,
+		association: association,
+	}
+}
+
+func (a *assignmentApi[ParentEntity, ChildEntity]) target() fiber.Handler {
+	return policies.Resolve[ParentEntity](a.storage, fmt.Sprintf(
+		"%sId",
+		inflect.Parameterize(a.parentName),
+	))
+}
+
+// queryError answers a request whose preloads or search columns could not be
+// resolved.
+func (a *assignmentApi[ParentEntity, ChildEntity]) queryError(ctx fiber.Ctx, err error) error {
+	if errors.Is(err, search.ErrInvalid) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Bad Request",
+			"message": err.Error(),
+		})
 	}
+
+	log.Errorf("🔥 Failed to resolve the %s query: %s", strings.ToLower(a.childName), err.Error())
+
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error":   "Internal Server Error",
+		"message": err.Error(),
+	})
 }
@@ -129,7 +129,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) AssignWithPayloadRoute(reques
 		a.baseUrl,
 		strings.ToLower(inflect.Dasherize(a.childName)),
 		inflect.Parameterize(a.parentName),
-	), Middlewares: middleware, Handler: func(ctx fiber.Ctx) error {
+	), Middlewares: append([]fiber.Handler{a.target()}, middleware...), Handler: func(ctx fiber.Ctx) error {
 		parentId := ctx.Params(fmt.Sprintf(
 			"%sId",
 			inflect.Parameterize(a.parentName),
@@ -174,10 +174,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) AssignWithPayloadRoute(reques
 				FullSaveAssociations: true,
 			}).
 			Model(&parentEntity).
-			Association(fmt.Sprintf(
-				"%s",
-				inflect.Pluralize(a.childName),
-			)).
+			Association(a.association).
 			Append(&childEntity); err != nil {
 			return ctx.Status(fiber.StatusInternalServerError).
 				JSON(fiber.Map{
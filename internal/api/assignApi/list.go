@@ -5,6 +5,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/inflect"
@@ -12,7 +13,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 type ListParams struct {
@@ -163,7 +163,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) ListRoute(middleware ...fiber
 			inflect.Parameterize(a.parentName),
 			strings.ToLower(inflect.Dasherize(inflect.Pluralize(a.childName))),
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{a.target()}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			parentId := ctx.Params(fmt.Sprintf(
 				"%sId",
@@ -203,29 +203,33 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) ListRoute(middleware ...fiber
 				})
 			}
 
-			clauses := []clause.Expression{}
+			columns := []string{}
 
-			if queryParams.SearchTerm != "" && len(queryParams.SearchColumns) > 0 {
-				for _, column := range queryParams.SearchColumns {
-					clauses = append(clauses, clause.Expr{
-						SQL:  fmt.Sprintf("%s ILIKE ?", column),
-						Vars: []any{fmt.Sprintf("%%%s%%", queryParams.SearchTerm)},
-					})
+			if queryParams.SearchTerm != "" {
+				resolved, err := search.Columns(a.storage.Database(), new(ChildEntity), queryParams.SearchColumns)
+
+				if err != nil {
+					return a.queryError(ctx, err)
 				}
+
+				columns = resolved
+			}
+
+			preloads, err := search.Preloads(a.storage.Database(), new(ChildEntity), queryParams.Preloads, nil)
+
+			if err != nil {
+				return a.queryError(ctx, err)
 			}
 
 			countQuery := a.storage.Database().
 				Model(&parentEntity)
 
-			if len(clauses) > 0 {
-				countQuery = countQuery.Clauses(clause.Or(clauses...))
+			if len(columns) > 0 {
+				countQuery = countQuery.Where(search.Where(a.storage.Database(), columns, queryParams.SearchTerm))
 			}
 
 			totalEntities := countQuery.
-				Association(fmt.Sprintf(
-					"%ss",
-					a.childName,
-				)).
+				Association(a.association).
 				Count()
 
 			if queryParams.Page < 1 {
@@ -264,31 +268,16 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) ListRoute(middleware ...fiber
 				query = query.Limit(limit).Offset(offset)
 			}
 
-			for _, preload := range queryParams.Preloads {
-				parts := strings.Split(preload, ".")
-
-				for i, part := range parts {
-					parts[i] = inflect.Camelize(strings.ToLower(part))
-				}
-
-				preload := strings.Join(parts, ".")
-
-				if preload == "" {
-					continue
-				}
-
+			for _, preload := range preloads {
 				query = query.Preload(preload)
 			}
 
-			if len(clauses) > 0 {
-				query = query.Clauses(clause.Or(clauses...))
+			if len(columns) > 0 {
+				query = query.Where(search.Where(a.storage.Database(), columns, queryParams.SearchTerm))
 			}
 
 			if err := query.
-				Association(fmt.Sprintf(
-					"%ss",
-					a.childName,
-				)).
+				Association(a.association).
 				Find(&existingAssociations); err != nil {
 				return ctx.Status(fiber.StatusInternalServerError).
 					JSON(fiber.Map{
@@ -140,7 +140,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) UnassignRoute(middleware ...f
 			inflect.Parameterize(a.parentName),
 			inflect.Parameterize(a.childName),
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{a.target()}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			parentId := ctx.Params(fmt.Sprintf(
 				"%sId",
@@ -201,10 +201,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) UnassignRoute(middleware ...f
 
 			if err := a.storage.Database().
 				Model(&parentEntity).
-				Association(fmt.Sprintf(
-					"%s",
-					inflect.Pluralize(a.childName),
-				)).
+				Association(a.association).
 				Find(&existingAssociation, "id = ?", childId); err != nil {
 				return ctx.Status(fiber.StatusInternalServerError).
 					JSON(fiber.Map{
@@ -227,10 +224,7 @@ func (a *assignmentApi[ParentEntity, ChildEntity]) UnassignRoute(middleware ...f
 
 			if err := a.storage.Database().
 				Model(&parentEntity).
-				Association(fmt.Sprintf(
-					"%s",
-					inflect.Pluralize(a.childName),
-				)).
+				Association(a.association).
 				Delete(&childEntity); err != nil {
 				return ctx.Status(fiber.StatusInternalServerError).
 					JSON(fiber.Map{
@@ -1,9 +1,15 @@
 package baseApi
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
 )
 
 type BaseApi[Entity any] interface {
@@ -19,12 +25,41 @@ type baseApi[Entity any] struct {
 	storage storage.Storage
 	baseUrl string
 	name    string
+	options
 }
 
-func New[Entity any](storage storage.Storage, baseUrl string, name string) BaseApi[Entity] {
-	return &baseApi[Entity]{
+func New[Entity any](storage storage.Storage, baseUrl string, name string, opts ...Option) BaseApi[Entity] {
+	api := &baseApi[Entity]{
 		storage: storage,
 		baseUrl: baseUrl,
 		name:    name,
 	}
+
+	for _, option := range opts {
+		option(&api.options)
+	}
+
+	return api
+}
+
+func (b *baseApi[Entity]) target(param string) fiber.Handler {
+	return policies.Resolve[Entity](b.storage, param)
+}
+
+// queryError answers a request whose preloads or search columns could not be
+// resolved.
+func (b *baseApi[Entity]) queryError(ctx fiber.Ctx, err error) error {
+	if errors.Is(err, search.ErrInvalid) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Bad Request",
+			"message": err.Error(),
+		})
+	}
+
+	log.Errorf("🔥 Failed to resolve the %s query: %s", strings.ToLower(b.name), err.Error())
+
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error":   "Internal Server Error",
+		"message": err.Error(),
+	})
 }
@@ -1,6 +1,7 @@
 package baseApi
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -107,7 +108,7 @@ func (b *baseApi[Entity]) CreateRoute(requestBodyRef string, middleware ...fiber
 			"%s",
 			b.baseUrl,
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			var entity *Entity
 
@@ -135,6 +136,25 @@ func (b *baseApi[Entity]) CreateRoute(requestBodyRef string, middleware ...fiber
 
 			entityValueId.Set(reflect.ValueOf(id))
 
+			if err := b.assign(ctx, entity); err != nil {
+				if errors.Is(err, ErrNoOrganization) {
+					return ctx.Status(fiber.StatusBadRequest).
+						JSON(fiber.Map{
+							"error": "Bad Request",
+							"message": fmt.Sprintf(
+								"Select an organization before creating a %s.",
+								strings.ToLower(b.name),
+							),
+						})
+				}
+
+				return ctx.Status(fiber.StatusInternalServerError).
+					JSON(fiber.Map{
+						"error":   "Internal Server Error",
+						"message": err.Error(),
+					})
+			}
+
 			if err := b.storage.Database().
 				Create(&entity).Error; err != nil {
 				return ctx.Status(fiber.StatusInternalServerError).
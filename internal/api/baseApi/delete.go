@@ -99,7 +99,7 @@ func (b *baseApi[Entity]) DeleteRoute(middleware ...fiber.Handler) routing.Route
 			}),
 	})
 
-	return routing.Route{
+	return b.scoped(routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary: fmt.Sprintf(
 				"Delete %s",
@@ -126,7 +126,7 @@ func (b *baseApi[Entity]) DeleteRoute(middleware ...fiber.Handler) routing.Route
 			"%s/{id}",
 			b.baseUrl,
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("id")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			var params DeleteParams
 
@@ -141,7 +141,7 @@ func (b *baseApi[Entity]) DeleteRoute(middleware ...fiber.Handler) routing.Route
 
 			var existingEntity Entity
 
-			if err := b.storage.Database().
+			if err := b.writable(ctx, b.storage.Database().Model(&existingEntity)).
 				Where("id = ?", params.Id).
 				First(&existingEntity).Error; err != nil {
 				if err == gorm.ErrRecordNotFound {
@@ -173,5 +173,5 @@ func (b *baseApi[Entity]) DeleteRoute(middleware ...fiber.Handler) routing.Route
 
 			return ctx.SendStatus(fiber.StatusOK)
 		},
-	}
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/inflect"
@@ -105,7 +106,7 @@ func (b *baseApi[Entity]) GetAllRoute(middleware ...fiber.Handler) routing.Route
 			}),
 	})
 
-	return routing.Route{
+	return b.scoped(routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary: fmt.Sprintf(
 				"Get %s",
@@ -147,7 +148,7 @@ func (b *baseApi[Entity]) GetAllRoute(middleware ...fiber.Handler) routing.Route
 			"%s",
 			b.baseUrl,
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			var query GetAllQueryParams
 
@@ -162,34 +163,28 @@ func (b *baseApi[Entity]) GetAllRoute(middleware ...fiber.Handler) routing.Route
 
 			var existingEntities []Entity
 
-			var baseQuery = b.storage.Database().Model(&existingEntities)
+			var baseQuery = b.scope(ctx, b.storage.Database().Model(&existingEntities))
 
-			for _, preload := range query.Preloads {
-				parts := strings.Split(preload, ".")
+			preloads, err := search.Preloads(b.storage.Database(), new(Entity), query.Preloads, b.preloads)
 
-				for i, part := range parts {
-					parts[i] = inflect.Camelize(strings.ToLower(part))
-				}
-
-				preload := strings.Join(parts, ".")
-
-				if preload == "" {
-					continue
-				}
+			if err != nil {
+				return b.queryError(ctx, err)
+			}
 
+			for _, preload := range preloads {
 				baseQuery = baseQuery.Preload(preload)
 			}
 
 			if query.SearchTerm != "" && len(query.SearchColumns) > 0 {
-				var searchConditions []string
-				var searchValues []interface{}
+				columns, err := search.Columns(b.storage.Database(), new(Entity), query.SearchColumns)
 
-				for _, column := range query.SearchColumns {
-					searchConditions = append(searchConditions, fmt.Sprintf("%s ILIKE ?", column))
-					searchValues = append(searchValues, fmt.Sprintf("%%%s%%", query.SearchTerm))
+				if err != nil {
+					return b.queryError(ctx, err)
 				}
 
-				baseQuery = baseQuery.Where(strings.Join(searchConditions, " OR "), searchValues...)
+				if len(columns) > 0 {
+					baseQuery = baseQuery.Where(search.Where(b.storage.Database(), columns, query.SearchTerm))
+				}
 			}
 
 			totalEntities := int64(0)
@@ -259,5 +254,5 @@ func (b *baseApi[Entity]) GetAllRoute(middleware ...fiber.Handler) routing.Route
 				},
 			})
 		},
-	}
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/inflect"
@@ -105,7 +106,7 @@ func (b *baseApi[Entity]) GetAllByFieldRoute(fieldName string, middleware ...fib
 			}),
 	})
 
-	return routing.Route{
+	return b.scoped(routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary: fmt.Sprintf(
 				"Get %s By %s",
@@ -162,7 +163,7 @@ func (b *baseApi[Entity]) GetAllByFieldRoute(fieldName string, middleware ...fib
 			b.baseUrl,
 			inflect.CamelizeDownFirst(fieldName),
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			fieldValue := ctx.Params(
 				inflect.CamelizeDownFirst(fieldName),
@@ -181,34 +182,28 @@ func (b *baseApi[Entity]) GetAllByFieldRoute(fieldName string, middleware ...fib
 
 			var existingEntities []Entity
 
-			var baseQuery = b.storage.Database().Model(&existingEntities)
+			var baseQuery = b.scope(ctx, b.storage.Database().Model(&existingEntities))
 
-			for _, preload := range query.Preloads {
-				parts := strings.Split(preload, ".")
+			preloads, err := search.Preloads(b.storage.Database(), new(Entity), query.Preloads, b.preloads)
 
-				for i, part := range parts {
-					parts[i] = inflect.Camelize(strings.ToLower(part))
-				}
-
-				preload := strings.Join(parts, ".")
-
-				if preload == "" {
-					continue
-				}
+			if err != nil {
+				return b.queryError(ctx, err)
+			}
 
+			for _, preload := range preloads {
 				baseQuery = baseQuery.Preload(preload)
 			}
 
 			if query.SearchTerm != "" && len(query.SearchColumns) > 0 {
-				var searchConditions []string
-				var searchValues []any
+				columns, err := search.Columns(b.storage.Database(), new(Entity), query.SearchColumns)
 
-				for _, column := range query.SearchColumns {
-					searchConditions = append(searchConditions, fmt.Sprintf("%s ILIKE ?", column))
-					searchValues = append(searchValues, fmt.Sprintf("%%%s%%", query.SearchTerm))
+				if err != nil {
+					return b.queryError(ctx, err)
 				}
 
-				baseQuery = baseQuery.Where(strings.Join(searchConditions, " OR "), searchValues...)
+				if len(columns) > 0 {
+					baseQuery = baseQuery.Where(search.Where(b.storage.Database(), columns, query.SearchTerm))
+				}
 			}
 
 			totalEntities := int64(0)
@@ -279,5 +274,5 @@ func (b *baseApi[Entity]) GetAllByFieldRoute(fieldName string, middleware ...fib
 				},
 			})
 		},
-	}
+	})
 }
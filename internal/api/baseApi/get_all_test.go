@@ -0,0 +1,59 @@
+package baseApi
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestGetAllQuery(t *testing.T) {
+	storage := testutil.DryRun(t)
+	api := New[models.Organization](
+		storage,
+		"/organizations",
+		"Organization",
+		WithTenancy(OrganizationColumn("id")),
+		WithPreloads("Owner"),
+	)
+
+	tests := []struct {
+		name   string
+		query  url.Values
+		status int
+	}{
+		{"no query", url.Values{}, fiber.StatusOK},
+		{"search", url.Values{"searchTerm": {"a"}, "searchColumn": {"name"}}, fiber.StatusOK},
+		{"search column injection", url.Values{"searchTerm": {"a"}, "searchColumn": {"true) OR (name"}}, fiber.StatusBadRequest},
+		{"unknown search column", url.Values{"searchTerm": {"a"}, "searchColumn": {"owner_id"}}, fiber.StatusBadRequest},
+		{"allowed preload", url.Values{"preload": {"owner"}}, fiber.StatusOK},
+		{"preload not allowed", url.Values{"preload": {"members"}}, fiber.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route := api.GetAllRoute()
+			app := fiber.New()
+
+			app.Get("/organizations", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", &models.User{Base: models.Base{Id: uuid.New()}, Type: models.UserTypeOrganizationUser})
+
+				return ctx.Next()
+			}, route.Middlewares[0], route.Handler)
+
+			response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/organizations?"+test.query.Encode(), nil))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/api/search"
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-openapi/inflect"
@@ -104,7 +105,7 @@ func (b *baseApi[Entity]) GetOneRoute(middleware ...fiber.Handler) routing.Route
 			}),
 	})
 
-	return routing.Route{
+	return b.scoped(routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary: fmt.Sprintf(
 				"Get %s",
@@ -134,7 +135,7 @@ func (b *baseApi[Entity]) GetOneRoute(middleware ...fiber.Handler) routing.Route
 			"%s/{id}",
 			b.baseUrl,
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("id")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			var params GetOneParams
 
@@ -160,21 +161,15 @@ func (b *baseApi[Entity]) GetOneRoute(middleware ...fiber.Handler) routing.Route
 
 			var existingEntity Entity
 
-			var baseQuery = b.storage.Database().Model(&existingEntity)
+			var baseQuery = b.scope(ctx, b.storage.Database().Model(&existingEntity))
 
-			for _, preload := range query.Preloads {
-				parts := strings.Split(preload, ".")
+			preloads, err := search.Preloads(b.storage.Database(), new(Entity), query.Preloads, b.preloads)
 
-				for i, part := range parts {
-					parts[i] = inflect.Camelize(strings.ToLower(part))
-				}
-
-				preload := strings.Join(parts, ".")
-
-				if preload == "" {
-					continue
-				}
+			if err != nil {
+				return b.queryError(ctx, err)
+			}
 
+			for _, preload := range preloads {
 				baseQuery = baseQuery.Preload(preload)
 			}
 
@@ -203,5 +198,5 @@ func (b *baseApi[Entity]) GetOneRoute(middleware ...fiber.Handler) routing.Route
 				"item": existingEntity,
 			})
 		},
-	}
+	})
 }
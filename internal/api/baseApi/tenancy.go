@@ -0,0 +1,263 @@
+package baseApi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/permissions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const scopeKey = "tenancy_scope"
+
+var ErrNoOrganization = errors.New("no active organization")
+
+type Option func(options *options)
+
+type options struct {
+	tenancy   []Tenancy
+	preloads  []string
+	updatable []string
+}
+
+type Tenancy struct {
+	Table  string
+	Key    string
+	Column string
+	Global bool
+}
+
+type tenancyScope struct {
+	systemWide      bool
+	organizationIds []uuid.UUID
+}
+
+func WithTenancy(tenancy ...Tenancy) Option {
+	return func(options *options) {
+		options.tenancy = append(options.tenancy, tenancy...)
+	}
+}
+
+// WithPreloads lists the relationships clients may preload. Related rows are
+// not organization scoped, so only relationships that stay inside the
+// parent's organization belong here.
+func WithPreloads(preloads ...string) Option {
+	return func(options *options) {
+		options.preloads = append(options.preloads, preloads...)
+	}
+}
+
+func OrganizationColumn(column string) Tenancy {
+	return Tenancy{
+		Column: column,
+	}
+}
+
+func OrganizationJoin(table string, key string, column string) Tenancy {
+	return Tenancy{
+		Table:  table,
+		Key:    key,
+		Column: column,
+	}
+}
+
+// WithGlobal makes rows whose organization column is NULL global. Everyone can
+// read them, but only system admins passing systemWide can change them.
+func (t Tenancy) WithGlobal() Tenancy {
+	t.Global = true
+
+	return t
+}
+
+// scoped wraps the route's handler rather than appending a middleware, so the
+// scope is only resolved after Authorized has accepted the request.
+func (b *baseApi[Entity]) scoped(route routing.Route) routing.Route {
+	if len(b.tenancy) == 0 {
+		return route
+	}
+
+	route.Parameters = append(route.Parameters, &openapi3.ParameterRef{
+		Ref: "#/components/parameters/SystemWide",
+	})
+	route.Handler = b.resolveScope(route.Handler)
+
+	return route
+}
+
+func (b *baseApi[Entity]) resolveScope(next fiber.Handler) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		currentUser, ok := ctx.Locals("user").(*models.User)
+
+		if !ok || currentUser == nil {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error":   "Unauthorized",
+				"message": "You must be logged in to access this resource.",
+			})
+		}
+
+		if ctx.Query("systemWide") == "true" {
+			if currentUser.Type != models.UserTypeSystemAdmin {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error":   "Forbidden",
+					"message": "Only system administrators can skip organization scoping.",
+				})
+			}
+
+			ctx.Locals(scopeKey, &tenancyScope{systemWide: true})
+
+			return next(ctx)
+		}
+
+		organizationIds, err := permissions.Organizations(b.storage.Database(), currentUser.Id)
+
+		if err != nil {
+			log.Errorf("🔥 Failed to retrieve the user's organizations: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		ctx.Locals(scopeKey, &tenancyScope{organizationIds: organizationIds})
+
+		return next(ctx)
+	}
+}
+
+// scope limits a read to rows in the current user's organizations and to
+// global rows.
+func (b *baseApi[Entity]) scope(ctx fiber.Ctx, query *gorm.DB) *gorm.DB {
+	return b.where(ctx, query, true)
+}
+
+// writable limits an update or delete to rows in the current user's
+// organizations, leaving global rows to system admins.
+func (b *baseApi[Entity]) writable(ctx fiber.Ctx, query *gorm.DB) *gorm.DB {
+	return b.where(ctx, query, false)
+}
+
+func (b *baseApi[Entity]) where(ctx fiber.Ctx, query *gorm.DB, global bool) *gorm.DB {
+	if len(b.tenancy) == 0 {
+		return query
+	}
+
+	current, ok := ctx.Locals(scopeKey).(*tenancyScope)
+
+	if !ok || current == nil {
+		return query.Where("1 = 0")
+	}
+
+	if current.systemWide {
+		return query
+	}
+
+	table := ""
+
+	if target, ok := ctx.Locals(policies.TargetKey).(*policies.Target); ok && target != nil {
+		table = target.Table
+	}
+
+	conditions := []string{}
+	values := []any{}
+
+	for _, tenancy := range b.tenancy {
+		if tenancy.Table == "" {
+			conditions = append(conditions, fmt.Sprintf("%s IN ?", qualify(table, tenancy.Column)))
+
+			if global && tenancy.Global {
+				conditions = append(conditions, fmt.Sprintf("%s IS NULL", qualify(table, tenancy.Column)))
+			}
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"%s IN (SELECT %s FROM %s WHERE %s IN ?)",
+				qualify(table, "id"),
+				tenancy.Key,
+				tenancy.Table,
+				tenancy.Column,
+			))
+		}
+
+		values = append(values, current.organizationIds)
+	}
+
+	return query.Where(fmt.Sprintf("(%s)", strings.Join(conditions, " OR ")), values...)
+}
+
+func (b *baseApi[Entity]) protectedColumns(ctx fiber.Ctx) []string {
+	if current, ok := ctx.Locals(scopeKey).(*tenancyScope); ok && current.systemWide {
+		return []string{}
+	}
+
+	columns := []string{}
+
+	for _, tenancy := range b.tenancy {
+		if tenancy.Table == "" {
+			columns = append(columns, tenancy.Column)
+		}
+	}
+
+	return columns
+}
+
+// assign sets the entity's organization columns to the current user's active
+// organization, so that rows created by anyone but a system admin land in the
+// organization they were created from.
+func (b *baseApi[Entity]) assign(ctx fiber.Ctx, entity *Entity) error {
+	if currentUser, ok := ctx.Locals("user").(*models.User); ok && currentUser.Type == models.UserTypeSystemAdmin {
+		return nil
+	}
+
+	statement := &gorm.Statement{DB: b.storage.Database()}
+
+	if err := statement.Parse(entity); err != nil {
+		return err
+	}
+
+	for _, tenancy := range b.tenancy {
+		if tenancy.Table != "" {
+			continue
+		}
+
+		field := statement.Schema.LookUpField(tenancy.Column)
+
+		if field == nil || field.PrimaryKey {
+			continue
+		}
+
+		organizationId, ok := ctx.Locals("organization_id").(uuid.UUID)
+
+		if !ok {
+			return ErrNoOrganization
+		}
+
+		var value any = organizationId
+
+		if field.FieldType.Kind() == reflect.Ptr {
+			value = &organizationId
+		}
+
+		if err := field.Set(ctx, reflect.ValueOf(entity).Elem(), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func qualify(table string, column string) string {
+	if table == "" {
+		return column
+	}
+
+	return fmt.Sprintf("%s.%s", table, column)
+}
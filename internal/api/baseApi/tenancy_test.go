@@ -0,0 +1,298 @@
+package baseApi
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/policies"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// run executes handler inside a request whose locals are set by locals.
+func run(t *testing.T, locals map[string]any, handler fiber.Handler) {
+	t.Helper()
+
+	app := fiber.New()
+
+	app.Get("/", func(ctx fiber.Ctx) error {
+		for key, value := range locals {
+			ctx.Locals(key, value)
+		}
+
+		return handler(ctx)
+	})
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	if response.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", response.StatusCode, fiber.StatusOK)
+	}
+}
+
+func TestWhere(t *testing.T) {
+	storage := testutil.DryRun(t)
+	organizationId := uuid.New()
+	scope := &tenancyScope{organizationIds: []uuid.UUID{organizationId}}
+	target := &policies.Target{Table: "roles"}
+
+	tests := []struct {
+		name     string
+		tenancy  []Tenancy
+		locals   map[string]any
+		writable bool
+		include  []string
+		exclude  []string
+	}{
+		{
+			name:    "no tenancy",
+			exclude: []string{"WHERE"},
+		},
+		{
+			name:    "missing scope matches nothing",
+			tenancy: []Tenancy{OrganizationColumn("organization_id")},
+			include: []string{"1 = 0"},
+		},
+		{
+			name:    "system wide skips scoping",
+			tenancy: []Tenancy{OrganizationColumn("organization_id")},
+			locals:  map[string]any{scopeKey: &tenancyScope{systemWide: true}},
+			exclude: []string{"WHERE"},
+		},
+		{
+			name:    "column",
+			tenancy: []Tenancy{OrganizationColumn("organization_id")},
+			locals:  map[string]any{scopeKey: scope},
+			include: []string{"(organization_id IN ($1))"},
+			exclude: []string{"IS NULL"},
+		},
+		{
+			name:    "column is qualified with the target table",
+			tenancy: []Tenancy{OrganizationColumn("organization_id")},
+			locals:  map[string]any{scopeKey: scope, policies.TargetKey: target},
+			include: []string{"(roles.organization_id IN ($1))"},
+		},
+		{
+			name:    "reads include global rows",
+			tenancy: []Tenancy{OrganizationColumn("organization_id").WithGlobal()},
+			locals:  map[string]any{scopeKey: scope, policies.TargetKey: target},
+			include: []string{"(roles.organization_id IN ($1) OR roles.organization_id IS NULL)"},
+		},
+		{
+			name:     "writes exclude global rows",
+			tenancy:  []Tenancy{OrganizationColumn("organization_id").WithGlobal()},
+			locals:   map[string]any{scopeKey: scope, policies.TargetKey: target},
+			writable: true,
+			include:  []string{"(roles.organization_id IN ($1))"},
+			exclude:  []string{"IS NULL"},
+		},
+		{
+			name: "joins",
+			tenancy: []Tenancy{
+				OrganizationJoin("organizations_members", "user_id", "organization_id"),
+				OrganizationJoin("organizations", "owner_id", "id"),
+			},
+			locals: map[string]any{scopeKey: scope, policies.TargetKey: &policies.Target{Table: "users"}},
+			include: []string{
+				"(users.id IN (SELECT user_id FROM organizations_members WHERE organization_id IN ($1)) OR users.id IN (SELECT owner_id FROM organizations WHERE id IN ($2)))",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := &baseApi[models.Role]{
+				storage: storage,
+				options: options{tenancy: test.tenancy},
+			}
+
+			run(t, test.locals, func(ctx fiber.Ctx) error {
+				query := storage.Database().Model(&models.Role{})
+
+				if test.writable {
+					query = api.writable(ctx, query)
+				} else {
+					query = api.scope(ctx, query)
+				}
+
+				sql := query.Find(&[]models.Role{}).Statement.SQL.String()
+
+				for _, fragment := range test.include {
+					if !strings.Contains(sql, fragment) {
+						t.Errorf("SQL = %s, missing %s", sql, fragment)
+					}
+				}
+
+				for _, fragment := range test.exclude {
+					if strings.Contains(sql, fragment) {
+						t.Errorf("SQL = %s, should not contain %s", sql, fragment)
+					}
+				}
+
+				return ctx.SendStatus(fiber.StatusOK)
+			})
+		})
+	}
+}
+
+func TestAssign(t *testing.T) {
+	storage := testutil.DryRun(t)
+	organizationId := uuid.New()
+	organizationUser := &models.User{Type: models.UserTypeOrganizationUser}
+	systemAdmin := &models.User{Type: models.UserTypeSystemAdmin}
+
+	t.Run("pointer column", func(t *testing.T) {
+		api := &baseApi[models.Role]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("organization_id").WithGlobal()}}}
+
+		run(t, map[string]any{"user": organizationUser, "organization_id": organizationId}, func(ctx fiber.Ctx) error {
+			role := models.Role{}
+
+			if err := api.assign(ctx, &role); err != nil {
+				t.Errorf("assign() error = %v", err)
+
+				return nil
+			}
+
+			if role.OrganizationId == nil || *role.OrganizationId != organizationId {
+				t.Errorf("OrganizationId = %v, want %v", role.OrganizationId, organizationId)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+
+	t.Run("value column", func(t *testing.T) {
+		api := &baseApi[models.OrganizationInvitation]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("organization_id")}}}
+
+		run(t, map[string]any{"user": organizationUser, "organization_id": organizationId}, func(ctx fiber.Ctx) error {
+			invitation := models.OrganizationInvitation{}
+
+			if err := api.assign(ctx, &invitation); err != nil {
+				t.Errorf("assign() error = %v", err)
+
+				return nil
+			}
+
+			if invitation.OrganizationId != organizationId {
+				t.Errorf("OrganizationId = %v, want %v", invitation.OrganizationId, organizationId)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+
+	t.Run("system admins choose the organization", func(t *testing.T) {
+		api := &baseApi[models.Role]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("organization_id").WithGlobal()}}}
+
+		run(t, map[string]any{"user": systemAdmin, "organization_id": organizationId}, func(ctx fiber.Ctx) error {
+			role := models.Role{}
+
+			if err := api.assign(ctx, &role); err != nil {
+				t.Errorf("assign() error = %v", err)
+
+				return nil
+			}
+
+			if role.OrganizationId != nil {
+				t.Errorf("OrganizationId = %v, want nil", role.OrganizationId)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+
+	t.Run("no active organization", func(t *testing.T) {
+		api := &baseApi[models.Role]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("organization_id").WithGlobal()}}}
+
+		run(t, map[string]any{"user": organizationUser}, func(ctx fiber.Ctx) error {
+			if err := api.assign(ctx, &models.Role{}); !errors.Is(err, ErrNoOrganization) {
+				t.Errorf("assign() error = %v, want %v", err, ErrNoOrganization)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+
+	t.Run("primary key column", func(t *testing.T) {
+		api := &baseApi[models.Organization]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("id")}}}
+
+		run(t, map[string]any{"user": organizationUser}, func(ctx fiber.Ctx) error {
+			organization := models.Organization{}
+
+			if err := api.assign(ctx, &organization); err != nil {
+				t.Errorf("assign() error = %v", err)
+
+				return nil
+			}
+
+			if organization.Id != uuid.Nil {
+				t.Errorf("Id = %v, want it left unset", organization.Id)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+
+	t.Run("join tenancies are ignored", func(t *testing.T) {
+		api := &baseApi[models.User]{storage: storage, options: options{tenancy: []Tenancy{OrganizationJoin("organizations_members", "user_id", "organization_id")}}}
+
+		run(t, map[string]any{"user": organizationUser}, func(ctx fiber.Ctx) error {
+			if err := api.assign(ctx, &models.User{}); err != nil {
+				t.Errorf("assign() error = %v", err)
+			}
+
+			return ctx.SendStatus(fiber.StatusOK)
+		})
+	})
+}
+
+func TestResolveScopeSystemWide(t *testing.T) {
+	storage := testutil.DryRun(t)
+	api := &baseApi[models.Role]{storage: storage, options: options{tenancy: []Tenancy{OrganizationColumn("organization_id")}}}
+
+	tests := []struct {
+		name   string
+		user   *models.User
+		status int
+	}{
+		{"system admin", &models.User{Type: models.UserTypeSystemAdmin}, fiber.StatusOK},
+		{"system user", &models.User{Type: models.UserTypeSystemUser}, fiber.StatusForbidden},
+		{"organization owner", &models.User{Type: models.UserTypeOrganizationOwner}, fiber.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+
+			app.Get("/", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", test.user)
+
+				return ctx.Next()
+			}, api.resolveScope(func(ctx fiber.Ctx) error {
+				if current, ok := ctx.Locals(scopeKey).(*tenancyScope); !ok || !current.systemWide {
+					t.Errorf("scope = %v, want system wide", ctx.Locals(scopeKey))
+				}
+
+				return ctx.SendStatus(fiber.StatusOK)
+			}))
+
+			response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/?systemWide=true", nil))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package baseApi
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/connor-davis/dialogue-video-analysis-tool/internal/routing"
@@ -15,6 +16,14 @@ type UpdateParams struct {
 	Id string `json:"id" msg:"id"`
 }
 
+// WithUpdatable lists the only columns the update route may change. Without
+// it every column not protected by tenancy can be updated.
+func WithUpdatable(columns ...string) Option {
+	return func(options *options) {
+		options.updatable = append(options.updatable, columns...)
+	}
+}
+
 func (b *baseApi[Entity]) UpdateRoute(requestBodyRef string, middleware ...fiber.Handler) routing.Route {
 	responses := openapi3.NewResponses()
 
@@ -99,7 +108,7 @@ func (b *baseApi[Entity]) UpdateRoute(requestBodyRef string, middleware ...fiber
 			}),
 	})
 
-	return routing.Route{
+	return b.scoped(routing.Route{
 		OpenAPIMetadata: routing.OpenAPIMetadata{
 			Summary: fmt.Sprintf(
 				"Update %s",
@@ -128,7 +137,7 @@ func (b *baseApi[Entity]) UpdateRoute(requestBodyRef string, middleware ...fiber
 			"%s/{id}",
 			b.baseUrl,
 		),
-		Middlewares: middleware,
+		Middlewares: append([]fiber.Handler{b.target("id")}, middleware...),
 		Handler: func(ctx fiber.Ctx) error {
 			var params UpdateParams
 
@@ -160,9 +169,38 @@ func (b *baseApi[Entity]) UpdateRoute(requestBodyRef string, middleware ...fiber
 				}
 			}
 
+			if len(b.updatable) > 0 {
+				for column := range entity {
+					if !slices.Contains(b.updatable, column) {
+						return ctx.Status(fiber.StatusBadRequest).
+							JSON(fiber.Map{
+								"error": "Bad Request",
+								"message": fmt.Sprintf(
+									"The %s's %s cannot be updated.",
+									strings.ToLower(b.name),
+									inflect.CamelizeDownFirst(column),
+								),
+							})
+					}
+				}
+			}
+
+			for _, column := range b.protectedColumns(ctx) {
+				if _, ok := entity[column]; ok {
+					return ctx.Status(fiber.StatusBadRequest).
+						JSON(fiber.Map{
+							"error": "Bad Request",
+							"message": fmt.Sprintf(
+								"The %s cannot be moved to another organization.",
+								strings.ToLower(b.name),
+							),
+						})
+				}
+			}
+
 			var existingEntity Entity
 
-			if err := b.storage.Database().
+			if err := b.writable(ctx, b.storage.Database().Model(&existingEntity)).
 				Where("id = ?", params.Id).
 				First(&existingEntity).Error; err != nil {
 				if err == gorm.ErrRecordNotFound {
@@ -208,5 +246,5 @@ func (b *baseApi[Entity]) UpdateRoute(requestBodyRef string, middleware ...fiber
 				"item": entity,
 			})
 		},
-	}
+	})
 }
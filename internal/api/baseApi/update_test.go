@@ -0,0 +1,76 @@
+package baseApi
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestUpdateUpdatable(t *testing.T) {
+	userId := uuid.New()
+	database := &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			if !statement.Contains(`FROM "users"`) {
+				return nil
+			}
+
+			return testutil.Row(map[string]any{"id": userId.String(), "name": "Jane"})
+		},
+	}
+	api := New[models.User](
+		testutil.Fake(t, database),
+		"/users",
+		"User",
+		WithTenancy(OrganizationJoin("organizations_members", "user_id", "organization_id")),
+		WithUpdatable("name", "bio"),
+	)
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"updatable columns", `{"name":"Jane","bio":"Hello"}`, fiber.StatusOK},
+		{"empty body", `{}`, fiber.StatusOK},
+		{"type", `{"name":"Jane","type":"system_admin"}`, fiber.StatusBadRequest},
+		{"password", `{"password":"c2VjcmV0"}`, fiber.StatusBadRequest},
+		{"mfa secret", `{"mfaSecret":"c2VjcmV0"}`, fiber.StatusBadRequest},
+		{"mfa enabled", `{"mfaEnabled":false}`, fiber.StatusBadRequest},
+		{"email verified", `{"email_verified":true}`, fiber.StatusBadRequest},
+		{"active", `{"active":true}`, fiber.StatusBadRequest},
+		{"email", `{"email":"jane@example.com"}`, fiber.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route := api.UpdateRoute("#/components/requestBodies/UpdateUserPayload")
+			app := fiber.New()
+
+			handlers := append(route.Middlewares, route.Handler)
+
+			app.Put("/users/:id", func(ctx fiber.Ctx) error {
+				ctx.Locals("user", &models.User{Base: models.Base{Id: uuid.New()}, Type: models.UserTypeSystemAdmin})
+
+				return ctx.Next()
+			}, handlers...)
+
+			request := httptest.NewRequest(fiber.MethodPut, "/users/"+userId.String()+"?systemWide=true", strings.NewReader(test.body))
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err := app.Test(request)
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != test.status {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.status)
+			}
+		})
+	}
+}
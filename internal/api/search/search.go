@@ -0,0 +1,120 @@
+// Package search checks the preload and search column names a client sends to
+// the list and get routes against the entity's schema, so that they can never
+// reach the SQL as anything but a known column or relationship.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/inflect"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var ErrInvalid = errors.New("invalid query")
+
+// Columns resolves names to the qualified database columns of model. Only
+// string columns that the API exposes can be searched.
+func Columns(db *gorm.DB, model any, names []string) ([]string, error) {
+	statement := &gorm.Statement{DB: db}
+
+	if err := statement.Parse(model); err != nil {
+		return nil, err
+	}
+
+	columns := []string{}
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		field := statement.Schema.LookUpField(name)
+
+		if field == nil || field.DBName == "" || field.IndirectFieldType.Kind() != reflect.String || hidden(field) {
+			return nil, fmt.Errorf("%w: %q is not a searchable column", ErrInvalid, name)
+		}
+
+		columns = append(columns, fmt.Sprintf("%s.%s", statement.Schema.Table, field.DBName))
+	}
+
+	return columns, nil
+}
+
+// Where matches rows where any of columns contains term. The conditions are
+// built on db, a fresh session, so that they stay grouped in their own
+// parentheses when added to a scoped query.
+func Where(db *gorm.DB, columns []string, term string) *gorm.DB {
+	pattern := fmt.Sprintf("%%%s%%", term)
+
+	for index, column := range columns {
+		condition := fmt.Sprintf("%s ILIKE ?", column)
+
+		if index == 0 {
+			db = db.Where(condition, pattern)
+		} else {
+			db = db.Or(condition, pattern)
+		}
+	}
+
+	return db
+}
+
+// Preloads normalizes names to relationship paths of model and rejects any
+// path that is not in allowed. Related rows are not organization scoped, so
+// a route only preloads what it explicitly allows.
+func Preloads(db *gorm.DB, model any, names []string, allowed []string) ([]string, error) {
+	statement := &gorm.Statement{DB: db}
+
+	if err := statement.Parse(model); err != nil {
+		return nil, err
+	}
+
+	preloads := []string{}
+
+	for _, name := range names {
+		parts := strings.Split(name, ".")
+
+		for index, part := range parts {
+			parts[index] = inflect.Camelize(strings.ToLower(part))
+		}
+
+		preload := strings.Join(parts, ".")
+
+		if preload == "" {
+			continue
+		}
+
+		if !slices.Contains(allowed, preload) || !related(statement.Schema, parts) {
+			return nil, fmt.Errorf("%w: %q cannot be preloaded", ErrInvalid, name)
+		}
+
+		preloads = append(preloads, preload)
+	}
+
+	return preloads, nil
+}
+
+func related(current *schema.Schema, path []string) bool {
+	for _, name := range path {
+		relationship, ok := current.Relationships.Relations[name]
+
+		if !ok || hidden(relationship.Field) {
+			return false
+		}
+
+		current = relationship.FieldSchema
+	}
+
+	return true
+}
+
+func hidden(field *schema.Field) bool {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	return name == "-"
+}
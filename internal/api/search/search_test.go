@@ -0,0 +1,117 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/google/uuid"
+)
+
+func TestColumns(t *testing.T) {
+	database := testutil.DryRun(t).Database()
+
+	tests := []struct {
+		name    string
+		columns []string
+		want    []string
+	}{
+		{"column names", []string{"name", "email"}, []string{"users.name", "users.email"}},
+		{"field names", []string{"Name"}, []string{"users.name"}},
+		{"empty names are skipped", []string{"", "name"}, []string{"users.name"}},
+		{"string types", []string{"type"}, []string{"users.type"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			columns, err := Columns(database, &models.User{}, test.columns)
+
+			if err != nil {
+				t.Fatalf("Columns() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(columns, test.want) {
+				t.Errorf("Columns() = %v, want %v", columns, test.want)
+			}
+		})
+	}
+}
+
+func TestColumnsRejects(t *testing.T) {
+	database := testutil.DryRun(t).Database()
+
+	tests := []struct {
+		name   string
+		column string
+	}{
+		{"unknown", "username"},
+		{"injection", "true) OR (email"},
+		{"hidden", "totp_algorithm"},
+		{"not a string", "password"},
+		{"boolean", "active"},
+		{"relationship", "roles"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Columns(database, &models.User{}, []string{"name", test.column}); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Columns(%q) error = %v, want %v", test.column, err, ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestWhereStaysGrouped(t *testing.T) {
+	database := testutil.DryRun(t).Database()
+
+	sql := database.
+		Model(&models.Role{}).
+		Where("organization_id IN ?", []uuid.UUID{uuid.New()}).
+		Where(Where(database, []string{"roles.name", "roles.description"}, "admin")).
+		Find(&[]models.Role{}).
+		Statement.SQL.String()
+
+	want := "WHERE organization_id IN ($1) AND (roles.name ILIKE $2 OR roles.description ILIKE $3)"
+
+	if !strings.Contains(sql, want) {
+		t.Errorf("SQL = %s, want it to contain %s", sql, want)
+	}
+}
+
+func TestPreloads(t *testing.T) {
+	database := testutil.DryRun(t).Database()
+	allowed := []string{"Owner", "Members", "Roles"}
+
+	preloads, err := Preloads(database, &models.Organization{}, []string{"owner", "MEMBERS", ""}, allowed)
+
+	if err != nil {
+		t.Fatalf("Preloads() error = %v", err)
+	}
+
+	if want := []string{"Owner", "Members"}; !reflect.DeepEqual(preloads, want) {
+		t.Errorf("Preloads() = %v, want %v", preloads, want)
+	}
+
+	rejected := []struct {
+		name    string
+		model   any
+		preload string
+		allowed []string
+	}{
+		{"not allowed", &models.Organization{}, "Members.Roles", allowed},
+		{"unknown relationship", &models.Organization{}, "Invitations", []string{"Invitations"}},
+		{"hidden relationship", &models.User{}, "Roles", []string{"Roles"}},
+		{"nothing allowed", &models.Organization{}, "Owner", nil},
+	}
+
+	for _, test := range rejected {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Preloads(database, test.model, []string{test.preload}, test.allowed); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Preloads(%q) error = %v, want %v", test.preload, err, ErrInvalid)
+			}
+		})
+	}
+}
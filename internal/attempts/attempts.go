@@ -0,0 +1,242 @@
+package attempts
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ScopeAccount = "account"
+	ScopeIp      = "ip"
+	ScopeMfa     = "mfa"
+)
+
+type Key struct {
+	Scope string
+	Value string
+}
+
+type Failure struct {
+	Reason    string
+	UserId    *uuid.UUID
+	IpAddress string
+}
+
+func Account(email string) Key {
+	return Key{
+		Scope: ScopeAccount,
+		Value: strings.ToLower(strings.TrimSpace(email)),
+	}
+}
+
+func Ip(ipAddress string) Key {
+	return Key{
+		Scope: ScopeIp,
+		Value: ipAddress,
+	}
+}
+
+func Mfa(userId uuid.UUID) Key {
+	return Key{
+		Scope: ScopeMfa,
+		Value: userId.String(),
+	}
+}
+
+func Check(storage storage.Storage, keys ...Key) (time.Duration, error) {
+	retryAfter := time.Duration(0)
+
+	for _, key := range keys {
+		var attempt models.AuthenticationAttempt
+
+		if err := storage.Database().
+			Where("scope = ? AND value = ?", key.Scope, key.Value).
+			First(&attempt).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
+			return 0, err
+		}
+
+		retryAfter = max(retryAfter, wait(attempt, time.Now()))
+	}
+
+	return retryAfter, nil
+}
+
+// upsert counts one more failure against a key in a single statement, so
+// concurrent failures can never overwrite each other's count. Failures start
+// over once the window or a previous lockout has passed.
+const upsert = `INSERT INTO authentication_attempts (scope, value, failures, last_failure_at, created_at, updated_at)
+VALUES (@scope, @value, 1, @now, @now, @now)
+ON CONFLICT (scope, value) DO UPDATE SET
+	failures = CASE
+		WHEN authentication_attempts.last_failure_at < @windowStart OR authentication_attempts.locked_until < @now THEN 1
+		ELSE authentication_attempts.failures + 1
+	END,
+	locked_until = CASE
+		WHEN authentication_attempts.last_failure_at < @windowStart OR authentication_attempts.locked_until < @now THEN NULL
+		ELSE authentication_attempts.locked_until
+	END,
+	last_failure_at = @now,
+	updated_at = @now
+RETURNING id, failures, last_failure_at, locked_until`
+
+func Fail(storage storage.Storage, failure Failure, keys ...Key) error {
+	now := time.Now()
+
+	return storage.Database().Transaction(func(tx *gorm.DB) error {
+		for _, key := range keys {
+			var attempt models.AuthenticationAttempt
+
+			if err := tx.Raw(upsert, map[string]any{
+				"scope":       key.Scope,
+				"value":       key.Value,
+				"now":         now,
+				"windowStart": now.Add(-window()),
+			}).Scan(&attempt).Error; err != nil {
+				return err
+			}
+
+			if attempt.Failures < threshold(key.Scope) || attempt.LockedUntil != nil {
+				continue
+			}
+
+			lockedUntil := now.Add(lockoutDuration())
+
+			// Only the failure that sets the lock records the lockout.
+			result := tx.
+				Model(&models.AuthenticationAttempt{}).
+				Where("id = ? AND locked_until IS NULL", attempt.Id).
+				Update("locked_until", lockedUntil)
+
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+				continue
+			}
+
+			if err := tx.Create(&models.LockoutEvent{
+				Scope:       key.Scope,
+				Value:       key.Value,
+				UserId:      failure.UserId,
+				IpAddress:   failure.IpAddress,
+				Reason:      failure.Reason,
+				Failures:    attempt.Failures,
+				LockedUntil: lockedUntil,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func Reset(storage storage.Storage, keys ...Key) error {
+	for _, key := range keys {
+		if err := storage.Database().
+			Where("scope = ? AND value = ?", key.Scope, key.Value).
+			Delete(&models.AuthenticationAttempt{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Unlock(storage storage.Storage, unlockedById uuid.UUID, keys ...Key) error {
+	now := time.Now()
+
+	return storage.Database().Transaction(func(tx *gorm.DB) error {
+		for _, key := range keys {
+			if err := tx.
+				Where("scope = ? AND value = ?", key.Scope, key.Value).
+				Delete(&models.AuthenticationAttempt{}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.
+				Model(&models.LockoutEvent{}).
+				Where("scope = ? AND value = ? AND unlocked_at IS NULL AND locked_until > ?", key.Scope, key.Value, now).
+				Updates(map[string]any{
+					"unlocked_at":    now,
+					"unlocked_by_id": unlockedById,
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func wait(attempt models.AuthenticationAttempt, now time.Time) time.Duration {
+	if attempt.LockedUntil != nil {
+		if now.Before(*attempt.LockedUntil) {
+			return attempt.LockedUntil.Sub(now)
+		}
+
+		return 0
+	}
+
+	if now.Sub(attempt.LastFailureAt) > window() {
+		return 0
+	}
+
+	delayAfter := envInt("API_LOCKOUT_DELAY_AFTER", 3)
+
+	if attempt.Failures < delayAfter {
+		return 0
+	}
+
+	delay := common.EnvDuration("API_LOCKOUT_BASE_DELAY", time.Second) << min(attempt.Failures-delayAfter, 10)
+	delay = min(delay, common.EnvDuration("API_LOCKOUT_MAX_DELAY", 30*time.Second))
+
+	return max(attempt.LastFailureAt.Add(delay).Sub(now), 0)
+}
+
+func threshold(scope string) int {
+	switch scope {
+	case ScopeIp:
+		return envInt("API_LOCKOUT_IP_THRESHOLD", 50)
+	case ScopeMfa:
+		return envInt("API_LOCKOUT_MFA_THRESHOLD", 5)
+	}
+
+	return envInt("API_LOCKOUT_THRESHOLD", 10)
+}
+
+func window() time.Duration {
+	return common.EnvDuration("API_LOCKOUT_WINDOW", 15*time.Minute)
+}
+
+func lockoutDuration() time.Duration {
+	return common.EnvDuration("API_LOCKOUT_DURATION", 15*time.Minute)
+}
+
+func envInt(key string, fallback int) int {
+	value := common.EnvInt(key, fallback)
+
+	if value < 1 {
+		return fallback
+	}
+
+	return value
+}
+
+func RetryAfter(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+}
@@ -0,0 +1,184 @@
+package attempts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/google/uuid"
+)
+
+func TestFail(t *testing.T) {
+	t.Setenv("API_LOCKOUT_THRESHOLD", "3")
+	t.Setenv("API_LOCKOUT_MFA_THRESHOLD", "2")
+	t.Setenv("API_LOCKOUT_WINDOW", "15m")
+	t.Setenv("API_LOCKOUT_DURATION", "10m")
+
+	attemptId := uuid.New()
+	lockedUntil := time.Now().Add(5 * time.Minute)
+
+	tests := []struct {
+		name     string
+		key      Key
+		failures int
+		locked   *time.Time
+		won      bool
+		lockout  bool
+	}{
+		{"below the threshold", Account("jane@example.com"), 2, nil, true, false},
+		{"at the threshold", Account("jane@example.com"), 3, nil, true, true},
+		{"thresholds are per scope", Mfa(uuid.New()), 2, nil, true, true},
+		{"already locked", Account("jane@example.com"), 4, &lockedUntil, true, false},
+		{"another failure set the lock first", Account("jane@example.com"), 3, nil, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := &testutil.Database{
+				Query: func(statement testutil.Statement) *testutil.Rows {
+					if !statement.Contains("INSERT INTO authentication_attempts") {
+						return nil
+					}
+
+					var locked any
+
+					if test.locked != nil {
+						locked = *test.locked
+					}
+
+					return testutil.Row(map[string]any{
+						"id":              attemptId.String(),
+						"failures":        int64(test.failures),
+						"last_failure_at": time.Now(),
+						"locked_until":    locked,
+					})
+				},
+				Exec: func(statement testutil.Statement) int64 {
+					if statement.Contains("locked_until IS NULL") && test.won {
+						return 1
+					}
+
+					return 0
+				},
+			}
+
+			if err := Fail(testutil.Fake(t, database), Failure{Reason: "invalid_password"}, test.key); err != nil {
+				t.Fatalf("Fail() error = %v", err)
+			}
+
+			if !database.Executed("ON CONFLICT (scope, value) DO UPDATE", "RETURNING id, failures") {
+				t.Error("the failure was not counted with an upsert")
+			}
+
+			locks := test.failures >= threshold(test.key.Scope) && test.locked == nil
+
+			if locked := database.Executed(`UPDATE "authentication_attempts"`, "locked_until IS NULL"); locked != locks {
+				t.Errorf("lock update executed = %v, want %v", locked, locks)
+			}
+
+			if recorded := database.Executed(`INSERT INTO "lockout_events"`); recorded != test.lockout {
+				t.Errorf("lockout event recorded = %v, want %v", recorded, test.lockout)
+			}
+		})
+	}
+}
+
+func TestFailStartsOver(t *testing.T) {
+	t.Setenv("API_LOCKOUT_WINDOW", "15m")
+
+	database := &testutil.Database{}
+
+	before := time.Now()
+
+	if err := Fail(testutil.Fake(t, database), Failure{}, Account("jane@example.com")); err != nil {
+		t.Fatalf("Fail() error = %v", err)
+	}
+
+	for _, statement := range database.Statements() {
+		if !statement.Contains("INSERT INTO authentication_attempts") {
+			continue
+		}
+
+		// The window start must be the window before the failure's time.
+		var now, windowStart time.Time
+
+		for _, arg := range statement.Args {
+			if value, ok := arg.(time.Time); ok {
+				if now.IsZero() || value.After(now) {
+					now = value
+				}
+
+				if windowStart.IsZero() || value.Before(windowStart) {
+					windowStart = value
+				}
+			}
+		}
+
+		if now.Before(before) || now.Sub(windowStart) != 15*time.Minute {
+			t.Errorf("now = %v, window start = %v, want the window start 15m before now", now, windowStart)
+		}
+
+		return
+	}
+
+	t.Fatal("the failure was not counted")
+}
+
+func TestWait(t *testing.T) {
+	t.Setenv("API_LOCKOUT_WINDOW", "15m")
+	t.Setenv("API_LOCKOUT_DELAY_AFTER", "3")
+	t.Setenv("API_LOCKOUT_BASE_DELAY", "1s")
+	t.Setenv("API_LOCKOUT_MAX_DELAY", "30s")
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(2 * time.Minute)
+	expired := now.Add(-time.Second)
+
+	tests := []struct {
+		name    string
+		attempt models.AuthenticationAttempt
+		want    time.Duration
+	}{
+		{"below the delay", models.AuthenticationAttempt{Failures: 2, LastFailureAt: now}, 0},
+		{"first delay", models.AuthenticationAttempt{Failures: 3, LastFailureAt: now}, time.Second},
+		{"delay doubles", models.AuthenticationAttempt{Failures: 5, LastFailureAt: now}, 4 * time.Second},
+		{"delay is capped", models.AuthenticationAttempt{Failures: 9, LastFailureAt: now}, 30 * time.Second},
+		{"delay already served", models.AuthenticationAttempt{Failures: 3, LastFailureAt: now.Add(-2 * time.Second)}, 0},
+		{"outside the window", models.AuthenticationAttempt{Failures: 9, LastFailureAt: now.Add(-16 * time.Minute)}, 0},
+		{"locked", models.AuthenticationAttempt{Failures: 10, LastFailureAt: now, LockedUntil: &lockedUntil}, 2 * time.Minute},
+		{"lockout expired", models.AuthenticationAttempt{Failures: 10, LastFailureAt: now, LockedUntil: &expired}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := wait(test.attempt, now); got != test.want {
+				t.Errorf("wait() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		want       string
+	}{
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{time.Millisecond, "1"},
+		{2 * time.Minute, "120"},
+	}
+
+	for _, test := range tests {
+		if got := RetryAfter(test.retryAfter); got != test.want {
+			t.Errorf("RetryAfter(%v) = %q, want %q", test.retryAfter, got, test.want)
+		}
+	}
+}
+
+func TestAccount(t *testing.T) {
+	if Account("  Someone@Example.com ") != Account("someone@example.com") {
+		t.Error("account keys are not normalized")
+	}
+}
@@ -0,0 +1,162 @@
+package authenticator
+
+import (
+	"crypto/subtle"
+	"encoding/base32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	Issuer    string
+	Period    uint
+	Skew      uint
+	Digits    otp.Digits
+	Algorithm otp.Algorithm
+}
+
+func Load() Config {
+	config := Config{
+		Issuer:    common.EnvString("API_TOTP_ISSUER", common.EnvString("API_NAME", "One REST API")),
+		Period:    30,
+		Skew:      1,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+
+	if period, err := strconv.ParseUint(common.EnvString("API_TOTP_PERIOD", "30"), 10, 32); err == nil && period > 0 {
+		config.Period = uint(period)
+	}
+
+	if skew, err := strconv.ParseUint(common.EnvString("API_TOTP_SKEW", "1"), 10, 32); err == nil {
+		config.Skew = uint(skew)
+	}
+
+	if common.EnvString("API_TOTP_DIGITS", "6") == "8" {
+		config.Digits = otp.DigitsEight
+	}
+
+	config.Algorithm = algorithm(common.EnvString("API_TOTP_ALGORITHM", "SHA1"))
+
+	return config
+}
+
+func algorithm(name string) otp.Algorithm {
+	switch strings.ToUpper(name) {
+	case "SHA256":
+		return otp.AlgorithmSHA256
+	case "SHA512":
+		return otp.AlgorithmSHA512
+	}
+
+	return otp.AlgorithmSHA1
+}
+
+func (c Config) ForUser(user *models.User) Config {
+	if user.TotpDigits == 0 {
+		return c
+	}
+
+	c.Period = user.TotpPeriod
+	c.Digits = otp.Digits(user.TotpDigits)
+	c.Algorithm = algorithm(user.TotpAlgorithm)
+
+	return c
+}
+
+func (c Config) Columns() map[string]any {
+	return map[string]any{
+		"totp_period":    c.Period,
+		"totp_digits":    c.Digits.Length(),
+		"totp_algorithm": c.Algorithm.String(),
+	}
+}
+
+func Backfill(storage storage.Storage) error {
+	columns := Load().Columns()
+	columns["totp_confirmed"] = gorm.Expr("mfa_enabled")
+
+	return storage.Database().
+		Model(&models.User{}).
+		Where("mfa_secret IS NOT NULL AND totp_digits = 0").
+		Updates(columns).Error
+}
+
+func (c Config) Generate(accountName string) (*otp.Key, error) {
+	return totp.Generate(totp.GenerateOpts{
+		Issuer:      c.Issuer,
+		AccountName: accountName,
+		Period:      c.Period,
+		Digits:      c.Digits,
+		Algorithm:   c.Algorithm,
+		SecretSize:  32,
+	})
+}
+
+func (c Config) Key(accountName string, secret []byte) (*otp.Key, error) {
+	secretBytes, err := base32.StdEncoding.WithPadding(base32.NoPadding).
+		DecodeString(string(secret))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return totp.Generate(totp.GenerateOpts{
+		Issuer:      c.Issuer,
+		AccountName: accountName,
+		Period:      c.Period,
+		Digits:      c.Digits,
+		Algorithm:   c.Algorithm,
+		Secret:      secretBytes,
+		SecretSize:  32,
+	})
+}
+
+func (c Config) Validate(storage storage.Storage, userId uuid.UUID, secret []byte, code string) (bool, error) {
+	if len(code) != c.Digits.Length() {
+		return false, nil
+	}
+
+	now := time.Now()
+	currentStep := now.Unix() / int64(c.Period)
+
+	for offset := -int64(c.Skew); offset <= int64(c.Skew); offset++ {
+		step := currentStep + offset
+
+		expected, err := totp.GenerateCodeCustom(string(secret), time.Unix(step*int64(c.Period), 0), totp.ValidateOpts{
+			Period:    c.Period,
+			Digits:    c.Digits,
+			Algorithm: c.Algorithm,
+		})
+
+		if err != nil {
+			return false, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+
+		result := storage.Database().
+			Model(&models.User{}).
+			Where("id = ? AND mfa_last_step < ?", userId, step).
+			Update("mfa_last_step", step)
+
+		if result.Error != nil {
+			return false, result.Error
+		}
+
+		return result.RowsAffected == 1, nil
+	}
+
+	return false, nil
+}
@@ -0,0 +1,114 @@
+package impersonation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+const Header = "X-Impersonated-By"
+
+var ErrNotImpersonating = errors.New("session is not impersonating a user")
+
+type Impersonation struct {
+	ActorId   uuid.UUID `json:"actorId"`
+	TargetId  uuid.UUID `json:"targetId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func Current(ctx fiber.Ctx) (*Impersonation, bool) {
+	session := session.FromContext(ctx)
+
+	actorId, err := uuid.Parse(toString(session.Get(sessions.ImpersonatorIdKey)))
+
+	if err != nil {
+		return nil, false
+	}
+
+	targetId, err := uuid.Parse(toString(session.Get(sessions.UserIdKey)))
+
+	if err != nil {
+		return nil, false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, toString(session.Get(sessions.ImpersonationExpiresAtKey)))
+
+	if err != nil {
+		return nil, false
+	}
+
+	return &Impersonation{
+		ActorId:   actorId,
+		TargetId:  targetId,
+		ExpiresAt: expiresAt,
+	}, true
+}
+
+func (i *Impersonation) Expired() bool {
+	return !time.Now().Before(i.ExpiresAt)
+}
+
+func Start(ctx fiber.Ctx, storage storage.Storage, actorId uuid.UUID, targetId uuid.UUID, reason string) (*Impersonation, error) {
+	impersonation := &Impersonation{
+		ActorId:   actorId,
+		TargetId:  targetId,
+		ExpiresAt: time.Now().Add(common.EnvDuration("API_IMPERSONATION_TTL", 30*time.Minute)),
+	}
+
+	if err := record(ctx, storage, impersonation, models.ImpersonationActionStart, reason); err != nil {
+		return nil, err
+	}
+
+	session := session.FromContext(ctx)
+
+	session.Set(sessions.ImpersonatorIdKey, actorId.String())
+	session.Set(sessions.ImpersonationExpiresAtKey, impersonation.ExpiresAt.Format(time.RFC3339))
+	session.Set(sessions.UserIdKey, targetId.String())
+
+	return impersonation, nil
+}
+
+func Stop(ctx fiber.Ctx, storage storage.Storage, action models.ImpersonationAction) (*Impersonation, error) {
+	impersonation, ok := Current(ctx)
+
+	if !ok {
+		return nil, ErrNotImpersonating
+	}
+
+	if err := record(ctx, storage, impersonation, action, ""); err != nil {
+		return nil, err
+	}
+
+	session := session.FromContext(ctx)
+
+	session.Delete(sessions.ImpersonatorIdKey)
+	session.Delete(sessions.ImpersonationExpiresAtKey)
+	session.Set(sessions.UserIdKey, impersonation.ActorId.String())
+
+	return impersonation, nil
+}
+
+func record(ctx fiber.Ctx, storage storage.Storage, impersonation *Impersonation, action models.ImpersonationAction, reason string) error {
+	return storage.Database().Create(&models.ImpersonationEvent{
+		ActorId:   impersonation.ActorId,
+		TargetId:  impersonation.TargetId,
+		Action:    action,
+		Reason:    reason,
+		IpAddress: ctx.IP(),
+		UserAgent: string(ctx.Request().Header.UserAgent()),
+		ExpiresAt: impersonation.ExpiresAt,
+	}).Error
+}
+
+func toString(value any) string {
+	text, _ := value.(string)
+
+	return text
+}
@@ -0,0 +1,152 @@
+package impersonation_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/impersonation"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/sessions"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+// run executes handler inside a request that has a fresh session.
+func run(t *testing.T, handler fiber.Handler) {
+	t.Helper()
+
+	app := fiber.New()
+
+	app.Use(session.New())
+	app.Get("/", handler)
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	if response.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", response.StatusCode, fiber.StatusOK)
+	}
+}
+
+func TestCompleteMfaKeepsImpersonation(t *testing.T) {
+	storage := testutil.DryRun(t)
+	actorId := uuid.New()
+	targetId := uuid.New()
+
+	run(t, func(ctx fiber.Ctx) error {
+		started, err := impersonation.Start(ctx, storage, actorId, targetId, "support")
+
+		if err != nil {
+			t.Errorf("Start() error = %v", err)
+
+			return nil
+		}
+
+		previousSessionId := session.FromContext(ctx).ID()
+
+		if err := sessions.CompleteMfa(ctx, storage, targetId); err != nil {
+			t.Errorf("CompleteMfa() error = %v", err)
+
+			return nil
+		}
+
+		if session.FromContext(ctx).ID() == previousSessionId {
+			t.Error("CompleteMfa() did not regenerate the session")
+		}
+
+		current, ok := impersonation.Current(ctx)
+
+		if !ok {
+			t.Error("impersonation was dropped by CompleteMfa()")
+
+			return nil
+		}
+
+		if current.ActorId != actorId || current.TargetId != targetId {
+			t.Errorf("Current() = %v -> %v, want %v -> %v", current.ActorId, current.TargetId, actorId, targetId)
+		}
+
+		if !current.ExpiresAt.Equal(started.ExpiresAt.Truncate(time.Second)) {
+			t.Errorf("ExpiresAt = %v, want %v", current.ExpiresAt, started.ExpiresAt)
+		}
+
+		if !sessions.MfaVerified(ctx) {
+			t.Error("MfaVerified() = false after CompleteMfa()")
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+}
+
+func TestAuthenticateEndsImpersonation(t *testing.T) {
+	storage := testutil.DryRun(t)
+
+	run(t, func(ctx fiber.Ctx) error {
+		if _, err := impersonation.Start(ctx, storage, uuid.New(), uuid.New(), ""); err != nil {
+			t.Errorf("Start() error = %v", err)
+
+			return nil
+		}
+
+		if err := sessions.Authenticate(ctx, storage, uuid.New()); err != nil {
+			t.Errorf("Authenticate() error = %v", err)
+
+			return nil
+		}
+
+		if _, ok := impersonation.Current(ctx); ok {
+			t.Error("a new login kept the previous impersonation")
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+}
+
+func TestStopRestoresActor(t *testing.T) {
+	storage := testutil.DryRun(t)
+	actorId := uuid.New()
+
+	run(t, func(ctx fiber.Ctx) error {
+		if _, err := impersonation.Stop(ctx, storage, models.ImpersonationActionStop); err != impersonation.ErrNotImpersonating {
+			t.Errorf("Stop() without an impersonation error = %v, want %v", err, impersonation.ErrNotImpersonating)
+		}
+
+		if _, err := impersonation.Start(ctx, storage, actorId, uuid.New(), ""); err != nil {
+			t.Errorf("Start() error = %v", err)
+
+			return nil
+		}
+
+		if _, err := impersonation.Stop(ctx, storage, models.ImpersonationActionStop); err != nil {
+			t.Errorf("Stop() error = %v", err)
+
+			return nil
+		}
+
+		if _, ok := impersonation.Current(ctx); ok {
+			t.Error("Current() still reports an impersonation after Stop()")
+		}
+
+		if userId := session.FromContext(ctx).Get(sessions.UserIdKey); userId != actorId.String() {
+			t.Errorf("session user = %v, want the actor %v", userId, actorId)
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+}
+
+func TestExpired(t *testing.T) {
+	if !(&impersonation.Impersonation{ExpiresAt: time.Now().Add(-time.Second)}).Expired() {
+		t.Error("Expired() = false for a past expiry")
+	}
+
+	if (&impersonation.Impersonation{ExpiresAt: time.Now().Add(time.Minute)}).Expired() {
+		t.Error("Expired() = true for a future expiry")
+	}
+}
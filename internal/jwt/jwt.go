@@ -0,0 +1,111 @@
+package jwt
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired access token")
+
+type Claims struct {
+	jwt.RegisteredClaims
+	FamilyId    uuid.UUID `json:"sid"`
+	MfaVerified bool      `json:"mfa"`
+}
+
+func issuer() string {
+	return common.EnvString("API_JWT_ISSUER", common.EnvString("API_NAME", "One REST API"))
+}
+
+func audience() string {
+	return common.EnvString("API_JWT_AUDIENCE", issuer())
+}
+
+func accessTokenTtl() time.Duration {
+	return common.EnvDuration("API_JWT_ACCESS_TTL", 15*time.Minute)
+}
+
+func Issue(storage storage.Storage, refreshToken *models.RefreshToken) (string, time.Time, error) {
+	signingKey, privateKey, err := signingKey(storage)
+
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(accessTokenTtl())
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    issuer(),
+			Subject:   refreshToken.UserId.String(),
+			Audience:  jwt.ClaimStrings{audience()},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		FamilyId:    refreshToken.FamilyId,
+		MfaVerified: refreshToken.MfaVerified,
+	})
+
+	token.Header["kid"] = signingKey.KeyId
+
+	signed, err := token.SignedString(privateKey)
+
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return signed, expiresAt, nil
+}
+
+func Verify(storage storage.Storage, token string) (*Claims, error) {
+	var claims Claims
+
+	parsed, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		func(token *jwt.Token) (any, error) {
+			keyId, ok := token.Header["kid"].(string)
+
+			if !ok {
+				return nil, ErrUnknownKey
+			}
+
+			return publicKey(storage, keyId)
+		},
+		jwt.WithValidMethods([]string{Algorithm}),
+		jwt.WithIssuer(issuer()),
+		jwt.WithAudience(audience()),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+
+	if err != nil {
+		if errors.Is(err, ErrUnknownKey) || errors.Is(err, jwt.ErrTokenMalformed) ||
+			errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenInvalidClaims) ||
+			errors.Is(err, jwt.ErrTokenUnverifiable) {
+			return nil, ErrInvalidToken
+		}
+
+		return nil, err
+	}
+
+	if !parsed.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return &claims, nil
+}
+
+func Looks(token string) bool {
+	return strings.HasPrefix(token, "ey") && strings.Count(token, ".") == 2
+}
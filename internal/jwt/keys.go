@@ -0,0 +1,189 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/secrets"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"gorm.io/gorm"
+)
+
+const Algorithm = "ES256"
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+type Jwk struct {
+	KeyType   string `json:"kty"`
+	KeyId     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+func rotationInterval() time.Duration {
+	return common.EnvDuration("API_JWT_KEY_ROTATION", 30*24*time.Hour)
+}
+
+func signingKey(storage storage.Storage) (*models.SigningKey, *ecdsa.PrivateKey, error) {
+	var signingKey models.SigningKey
+
+	err := storage.Database().
+		Where("created_at > ? AND expires_at > ?", time.Now().Add(-rotationInterval()), time.Now()).
+		Order("created_at DESC").
+		First(&signingKey).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return rotate(storage)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext, err := secrets.Decrypt(signingKey.PrivateKey)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKey, err := x509.ParseECPrivateKey(plaintext)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &signingKey, privateKey, nil
+}
+
+func rotate(storage storage.Storage) (*models.SigningKey, *ecdsa.PrivateKey, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateBytes, err := x509.MarshalECPrivateKey(privateKey)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encrypted, err := secrets.Encrypt(privateBytes)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyId := make([]byte, 16)
+
+	if _, err := rand.Read(keyId); err != nil {
+		return nil, nil, err
+	}
+
+	signingKey := models.SigningKey{
+		KeyId:      base64.RawURLEncoding.EncodeToString(keyId),
+		Algorithm:  Algorithm,
+		PrivateKey: encrypted,
+		PublicKey:  publicBytes,
+		ExpiresAt:  time.Now().Add(rotationInterval() + accessTokenTtl()),
+	}
+
+	if err := storage.Database().Create(&signingKey).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &signingKey, privateKey, nil
+}
+
+func publicKey(storage storage.Storage, keyId string) (*ecdsa.PublicKey, error) {
+	var signingKey models.SigningKey
+
+	if err := storage.Database().
+		Where("key_id = ? AND expires_at > ?", keyId, time.Now()).
+		First(&signingKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnknownKey
+		}
+
+		return nil, err
+	}
+
+	return parsePublicKey(signingKey.PublicKey)
+}
+
+func parsePublicKey(value []byte) (*ecdsa.PublicKey, error) {
+	parsed, err := x509.ParsePKIXPublicKey(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, ok := parsed.(*ecdsa.PublicKey)
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return publicKey, nil
+}
+
+func PublicKeys(storage storage.Storage) (*Jwks, error) {
+	var signingKeys []models.SigningKey
+
+	if err := storage.Database().
+		Where("expires_at > ?", time.Now()).
+		Order("created_at DESC").
+		Find(&signingKeys).Error; err != nil {
+		return nil, err
+	}
+
+	jwks := &Jwks{
+		Keys: []Jwk{},
+	}
+
+	for _, signingKey := range signingKeys {
+		publicKey, err := parsePublicKey(signingKey.PublicKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		point, err := publicKey.Bytes()
+
+		if err != nil {
+			return nil, err
+		}
+
+		jwks.Keys = append(jwks.Keys, Jwk{
+			KeyType:   "EC",
+			KeyId:     signingKey.KeyId,
+			Use:       "sig",
+			Algorithm: signingKey.Algorithm,
+			Curve:     "P-256",
+			X:         base64.RawURLEncoding.EncodeToString(point[1:33]),
+			Y:         base64.RawURLEncoding.EncodeToString(point[33:65]),
+		})
+	}
+
+	return jwks, nil
+}
@@ -0,0 +1,185 @@
+package jwt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const refreshTokenPrefix = "rt_"
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+)
+
+type Pair struct {
+	AccessToken      string `json:"accessToken"`
+	TokenType        string `json:"tokenType"`
+	ExpiresIn        int    `json:"expiresIn"`
+	RefreshToken     string `json:"refreshToken"`
+	RefreshExpiresIn int    `json:"refreshExpiresIn"`
+}
+
+func refreshTokenTtl() time.Duration {
+	return common.EnvDuration("API_JWT_REFRESH_TTL", 30*24*time.Hour)
+}
+
+func IssuePair(storage storage.Storage, user *models.User, mfaVerified bool, ipAddress string, userAgent string) (*Pair, error) {
+	return issuePair(storage.Database(), storage, models.RefreshToken{
+		UserId:      user.Id,
+		FamilyId:    uuid.New(),
+		MfaVerified: mfaVerified,
+		IpAddress:   ipAddress,
+		UserAgent:   userAgent,
+		ExpiresAt:   time.Now().Add(refreshTokenTtl()),
+	})
+}
+
+func Refresh(storage storage.Storage, token string, ipAddress string, userAgent string) (*Pair, error) {
+	var pair *Pair
+
+	err := storage.Database().Transaction(func(tx *gorm.DB) error {
+		var refreshToken models.RefreshToken
+
+		if err := tx.Where("token_hash = ?", tokens.Hash(token)).First(&refreshToken).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+
+			return err
+		}
+
+		if refreshToken.RevokedAt != nil || !refreshToken.ExpiresAt.After(time.Now()) {
+			return ErrInvalidRefreshToken
+		}
+
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL", refreshToken.Id).
+			Update("used_at", time.Now())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrRefreshTokenReused
+		}
+
+		var user models.User
+
+		if err := tx.Where("id = ?", refreshToken.UserId).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+
+			return err
+		}
+
+		if !user.Active {
+			return ErrInvalidRefreshToken
+		}
+
+		issued, err := issuePair(tx, storage, models.RefreshToken{
+			UserId:      refreshToken.UserId,
+			FamilyId:    refreshToken.FamilyId,
+			MfaVerified: refreshToken.MfaVerified,
+			IpAddress:   ipAddress,
+			UserAgent:   userAgent,
+			ExpiresAt:   refreshToken.ExpiresAt,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		pair = issued
+
+		return nil
+	})
+
+	if errors.Is(err, ErrRefreshTokenReused) {
+		if revokeErr := RevokeFamily(storage, token); revokeErr != nil {
+			return nil, revokeErr
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func RevokeFamily(storage storage.Storage, token string) error {
+	var refreshToken models.RefreshToken
+
+	if err := storage.Database().Where("token_hash = ?", tokens.Hash(token)).First(&refreshToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidRefreshToken
+		}
+
+		return err
+	}
+
+	return storage.Database().
+		Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", refreshToken.FamilyId).
+		Update("revoked_at", time.Now()).Error
+}
+
+func RevokeUser(storage storage.Storage, userId uuid.UUID) error {
+	return storage.Database().
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId).
+		Update("revoked_at", time.Now()).Error
+}
+
+func FamilyRevoked(storage storage.Storage, familyId uuid.UUID) (bool, error) {
+	var count int64
+
+	if err := storage.Database().
+		Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NOT NULL", familyId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func issuePair(tx *gorm.DB, storage storage.Storage, refreshToken models.RefreshToken) (*Pair, error) {
+	secret, _, err := tokens.Generate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	token := refreshTokenPrefix + secret
+
+	refreshToken.TokenHash = tokens.Hash(token)
+
+	if err := tx.Create(&refreshToken).Error; err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := Issue(storage, &refreshToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pair{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int(time.Until(expiresAt).Seconds()),
+		RefreshToken:     token,
+		RefreshExpiresIn: int(time.Until(refreshToken.ExpiresAt).Seconds()),
+	}, nil
+}
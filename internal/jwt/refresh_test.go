@@ -0,0 +1,137 @@
+package jwt
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/google/uuid"
+)
+
+// newRefreshStorage answers Refresh's statements as if refreshToken were the
+// only row in refresh_tokens.
+func newRefreshStorage(t *testing.T, refreshToken *models.RefreshToken) (*testutil.Storage, *testutil.Database) {
+	t.Helper()
+
+	database := &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			if !statement.Contains(`FROM "refresh_tokens"`) || len(statement.Args) == 0 {
+				return nil
+			}
+
+			if hash, ok := statement.Args[0].([]byte); !ok || !bytes.Equal(hash, refreshToken.TokenHash) {
+				return nil
+			}
+
+			return testutil.Row(map[string]any{
+				"id":         refreshToken.Id.String(),
+				"user_id":    refreshToken.UserId.String(),
+				"family_id":  refreshToken.FamilyId.String(),
+				"token_hash": refreshToken.TokenHash,
+				"expires_at": refreshToken.ExpiresAt,
+				"used_at":    nullable(refreshToken.UsedAt),
+				"revoked_at": nullable(refreshToken.RevokedAt),
+			})
+		},
+		Exec: func(statement testutil.Statement) int64 {
+			if statement.Contains(`SET "used_at"`) && refreshToken.UsedAt == nil {
+				return 1
+			}
+
+			return 0
+		},
+	}
+
+	return testutil.Fake(t, database), database
+}
+
+func nullable(value *time.Time) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
+
+func refreshToken(token string, change func(refreshToken *models.RefreshToken)) *models.RefreshToken {
+	refreshToken := &models.RefreshToken{
+		Base:      models.Base{Id: uuid.New()},
+		UserId:    uuid.New(),
+		FamilyId:  uuid.New(),
+		TokenHash: tokens.Hash(token),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	if change != nil {
+		change(refreshToken)
+	}
+
+	return refreshToken
+}
+
+func TestRefreshDetectsReuse(t *testing.T) {
+	usedAt := time.Now().Add(-time.Minute)
+	storage, fake := newRefreshStorage(t, refreshToken("rt_used", func(refreshToken *models.RefreshToken) {
+		refreshToken.UsedAt = &usedAt
+	}))
+
+	pair, err := Refresh(storage, "rt_used", "127.0.0.1", "test")
+
+	if !errors.Is(err, ErrRefreshTokenReused) || pair != nil {
+		t.Fatalf("Refresh() = %v, %v, want %v", pair, err, ErrRefreshTokenReused)
+	}
+
+	if !fake.Executed(`SET "used_at"`, "used_at IS NULL") {
+		t.Error("the refresh token was not claimed with a conditional update")
+	}
+
+	if !fake.Executed("ROLLBACK") {
+		t.Error("the refresh transaction was not rolled back")
+	}
+
+	if !fake.Executed(`SET "revoked_at"`, "family_id = ") {
+		t.Error("reusing a refresh token did not revoke its family")
+	}
+
+	if fake.Executed(`INSERT INTO "refresh_tokens"`) {
+		t.Error("a reused refresh token issued a new one")
+	}
+}
+
+func TestRefreshRejectsInvalidTokens(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name         string
+		token        string
+		refreshToken *models.RefreshToken
+	}{
+		{"unknown", "rt_unknown", refreshToken("rt_other", nil)},
+		{"revoked", "rt_revoked", refreshToken("rt_revoked", func(refreshToken *models.RefreshToken) {
+			refreshToken.RevokedAt = &revokedAt
+		})},
+		{"expired", "rt_expired", refreshToken("rt_expired", func(refreshToken *models.RefreshToken) {
+			refreshToken.ExpiresAt = time.Now().Add(-time.Second)
+		})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storage, fake := newRefreshStorage(t, test.refreshToken)
+
+			pair, err := Refresh(storage, test.token, "127.0.0.1", "test")
+
+			if !errors.Is(err, ErrInvalidRefreshToken) || pair != nil {
+				t.Fatalf("Refresh() = %v, %v, want %v", pair, err, ErrInvalidRefreshToken)
+			}
+
+			if fake.Executed("UPDATE") {
+				t.Error("an invalid refresh token changed stored tokens")
+			}
+		})
+	}
+}
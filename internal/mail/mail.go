@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/gofiber/fiber/v3/log"
+)
+
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+}
+
+type Sender interface {
+	Send(message Message) error
+}
+
+func New() Sender {
+	switch common.EnvString("MAIL_DRIVER", "outbox") {
+	case "smtp":
+		return NewSmtp(SmtpConfig{
+			Host:     common.EnvString("MAIL_SMTP_HOST", "localhost"),
+			Port:     common.EnvString("MAIL_SMTP_PORT", "587"),
+			Username: common.EnvString("MAIL_SMTP_USERNAME", ""),
+			Password: common.EnvString("MAIL_SMTP_PASSWORD", ""),
+			From:     common.EnvString("MAIL_FROM", "no-reply@localhost"),
+			Tls:      common.EnvString("MAIL_SMTP_TLS", "false") == "true",
+		})
+	case "outbox":
+		return NewOutbox(common.EnvString("MAIL_OUTBOX_DIR", "outbox"))
+	default:
+		log.Warnf("🚫 Unknown mail driver %s, falling back to the outbox.", common.EnvString("MAIL_DRIVER", ""))
+
+		return NewOutbox(common.EnvString("MAIL_OUTBOX_DIR", "outbox"))
+	}
+}
+
+func (m Message) Bytes(from string) []byte {
+	return []byte(fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		from,
+		strings.Join(m.To, ", "),
+		m.Subject,
+		time.Now().Format(time.RFC1123Z),
+		strings.ReplaceAll(m.Text, "\n", "\r\n"),
+	))
+}
@@ -0,0 +1,49 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/gofiber/fiber/v3/log"
+	"github.com/google/uuid"
+)
+
+type outbox struct {
+	directory string
+}
+
+func NewOutbox(directory string) Sender {
+	return &outbox{
+		directory: directory,
+	}
+}
+
+func (o *outbox) Send(message Message) error {
+	if err := os.MkdirAll(o.directory, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf(
+		"%s-%s.eml",
+		time.Now().UTC().Format("20060102T150405Z"),
+		uuid.NewString(),
+	)
+
+	path := filepath.Join(o.directory, name)
+
+	if err := os.WriteFile(
+		path,
+		message.Bytes(common.EnvString("MAIL_FROM", "no-reply@localhost")),
+		0o600,
+	); err != nil {
+		return err
+	}
+
+	log.Infof("📧 Mail to %s written to %s", strings.Join(message.To, ", "), path)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package mail
+
+import (
+	"crypto/tls"
+	"net"
+	"net/smtp"
+)
+
+type SmtpConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Tls      bool
+}
+
+type smtpSender struct {
+	config SmtpConfig
+}
+
+func NewSmtp(config SmtpConfig) Sender {
+	return &smtpSender{
+		config: config,
+	}
+}
+
+func (s *smtpSender) Send(message Message) error {
+	address := net.JoinHostPort(s.config.Host, s.config.Port)
+
+	var auth smtp.Auth
+
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	if !s.config.Tls {
+		return smtp.SendMail(address, auth, s.config.From, message.To, message.Bytes(s.config.From))
+	}
+
+	connection, err := tls.Dial("tcp", address, &tls.Config{
+		ServerName: s.config.Host,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(connection, s.config.Host)
+
+	if err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.config.From); err != nil {
+		return err
+	}
+
+	for _, recipient := range message.To {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	writer, err := client.Data()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(message.Bytes(s.config.From)); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ApiToken struct {
+	Base
+	UserId     uuid.UUID      `json:"userId" gorm:"type:uuid;index;not null"`
+	User       User           `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name       string         `json:"name" gorm:"type:text;not null"`
+	TokenHash  []byte         `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	Prefix     string         `json:"prefix" gorm:"type:text;not null"`
+	Scopes     pq.StringArray `json:"scopes" gorm:"type:text[]"`
+	ExpiresAt  time.Time      `json:"expiresAt" gorm:"not null"`
+	LastUsedAt *time.Time     `json:"lastUsedAt"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type AuthenticationAttempt struct {
+	Base
+	Scope         string     `json:"scope" gorm:"type:text;uniqueIndex:idx_authentication_attempts_scope_value;not null"`
+	Value         string     `json:"value" gorm:"type:text;uniqueIndex:idx_authentication_attempts_scope_value;not null"`
+	Failures      int        `json:"failures" gorm:"type:integer;default:0;not null"`
+	LastFailureAt time.Time  `json:"lastFailureAt" gorm:"not null"`
+	LockedUntil   *time.Time `json:"lockedUntil"`
+}
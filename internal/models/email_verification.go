@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerification struct {
+	Base
+	UserId    uuid.UUID  `json:"userId" gorm:"type:uuid;index;not null"`
+	User      User       `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	TokenHash []byte     `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	ExpiresAt time.Time  `json:"expiresAt" gorm:"not null"`
+	UsedAt    *time.Time `json:"usedAt"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImpersonationAction string
+
+const (
+	ImpersonationActionStart  ImpersonationAction = "start"
+	ImpersonationActionStop   ImpersonationAction = "stop"
+	ImpersonationActionExpire ImpersonationAction = "expire"
+)
+
+type ImpersonationEvent struct {
+	Base
+	ActorId   uuid.UUID           `json:"actorId" gorm:"type:uuid;index;not null"`
+	Actor     User                `json:"-" gorm:"foreignKey:ActorId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	TargetId  uuid.UUID           `json:"targetId" gorm:"type:uuid;index;not null"`
+	Target    User                `json:"-" gorm:"foreignKey:TargetId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Action    ImpersonationAction `json:"action" gorm:"type:text;not null"`
+	Reason    string              `json:"reason" gorm:"type:text"`
+	IpAddress string              `json:"ipAddress" gorm:"type:text"`
+	UserAgent string              `json:"userAgent" gorm:"type:text"`
+	ExpiresAt time.Time           `json:"expiresAt" gorm:"not null"`
+}
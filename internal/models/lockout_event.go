@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LockoutEvent struct {
+	Base
+	Scope        string     `json:"scope" gorm:"type:text;index;not null"`
+	Value        string     `json:"value" gorm:"type:text;index;not null"`
+	UserId       *uuid.UUID `json:"userId" gorm:"type:uuid;index"`
+	IpAddress    string     `json:"ipAddress" gorm:"type:text"`
+	Reason       string     `json:"reason" gorm:"type:text;not null"`
+	Failures     int        `json:"failures" gorm:"type:integer;not null"`
+	LockedUntil  time.Time  `json:"lockedUntil" gorm:"not null"`
+	UnlockedAt   *time.Time `json:"unlockedAt"`
+	UnlockedById *uuid.UUID `json:"unlockedById" gorm:"type:uuid"`
+}
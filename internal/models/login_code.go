@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginCode struct {
+	Base
+	UserId      uuid.UUID  `json:"userId" gorm:"type:uuid;index;not null"`
+	User        User       `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	TokenHash   []byte     `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	CodeHash    []byte     `json:"-" gorm:"type:bytea;not null"`
+	BrowserHash []byte     `json:"-" gorm:"type:bytea;index;not null"`
+	Failures    int        `json:"failures" gorm:"type:integer;default:0;not null"`
+	ExpiresAt   time.Time  `json:"expiresAt" gorm:"not null"`
+	UsedAt      *time.Time `json:"usedAt"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MfaRecoveryCode struct {
+	Base
+	UserId   uuid.UUID  `json:"userId" gorm:"type:uuid;index;not null"`
+	User     User       `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CodeHash []byte     `json:"-" gorm:"type:bytea;not null"`
+	UsedAt   *time.Time `json:"usedAt"`
+}
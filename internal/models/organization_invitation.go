@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationInvitation struct {
+	Base
+	OrganizationId uuid.UUID    `json:"organizationId" gorm:"type:uuid;index;not null"`
+	Organization   Organization `json:"-" gorm:"foreignKey:OrganizationId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Email          string       `json:"email" gorm:"type:text;index;not null"`
+	RoleId         uuid.UUID    `json:"roleId" gorm:"type:uuid;not null"`
+	Role           Role         `json:"role" gorm:"foreignKey:RoleId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	InvitedById    *uuid.UUID   `json:"invitedById" gorm:"type:uuid"`
+	InvitedBy      *User        `json:"-" gorm:"foreignKey:InvitedById;references:Id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	TokenHash      []byte       `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	ExpiresAt      time.Time    `json:"expiresAt" gorm:"not null"`
+	SentAt         time.Time    `json:"sentAt" gorm:"not null"`
+	AcceptedAt     *time.Time   `json:"acceptedAt"`
+	AcceptedById   *uuid.UUID   `json:"acceptedById" gorm:"type:uuid"`
+	RevokedAt      *time.Time   `json:"revokedAt"`
+}
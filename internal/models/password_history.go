@@ -0,0 +1,12 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+type PasswordHistory struct {
+	Base
+	UserId uuid.UUID `json:"userId" gorm:"type:uuid;index;not null"`
+	User   User      `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Hash   []byte    `json:"-" gorm:"type:bytea;not null"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type PolicyType string
+
+const (
+	PolicySystemAdmin       PolicyType = "system_admin"
+	PolicySelf              PolicyType = "self"
+	PolicyOrganizationOwner PolicyType = "organization_owner"
+	PolicySameOrganization  PolicyType = "same_organization"
+)
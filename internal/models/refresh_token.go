@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshToken struct {
+	Base
+	UserId      uuid.UUID  `json:"userId" gorm:"type:uuid;index;not null"`
+	User        User       `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	FamilyId    uuid.UUID  `json:"familyId" gorm:"type:uuid;index;not null"`
+	TokenHash   []byte     `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	MfaVerified bool       `json:"mfaVerified" gorm:"type:boolean;default:false;not null"`
+	IpAddress   string     `json:"ipAddress" gorm:"type:text"`
+	UserAgent   string     `json:"userAgent" gorm:"type:text"`
+	ExpiresAt   time.Time  `json:"expiresAt" gorm:"not null"`
+	UsedAt      *time.Time `json:"usedAt"`
+	RevokedAt   *time.Time `json:"revokedAt"`
+}
@@ -1,10 +1,15 @@
 package models
 
-import "github.com/lib/pq"
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
 
 type Role struct {
 	Base
-	Name        string         `json:"name" gorm:"type:text;uniqueIndex;not null"`
-	Description string         `json:"description" gorm:"type:text"`
-	Permissions pq.StringArray `json:"permissions" gorm:"type:text[]"`
+	Name           string         `json:"name" gorm:"type:text;uniqueIndex:idx_roles_organization_name,priority:2;uniqueIndex:idx_roles_global_name,where:organization_id IS NULL;not null"`
+	Description    string         `json:"description" gorm:"type:text"`
+	Permissions    pq.StringArray `json:"permissions" gorm:"type:text[]"`
+	OrganizationId *uuid.UUID     `json:"organizationId" gorm:"type:uuid;index;uniqueIndex:idx_roles_organization_name,priority:1"`
+	Organization   *Organization  `json:"-" gorm:"foreignKey:OrganizationId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScimToken struct {
+	Base
+	OrganizationId uuid.UUID    `json:"organizationId" gorm:"type:uuid;index;not null"`
+	Organization   Organization `json:"-" gorm:"foreignKey:OrganizationId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name           string       `json:"name" gorm:"type:text;not null"`
+	TokenHash      []byte       `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	Prefix         string       `json:"prefix" gorm:"type:text;not null"`
+	LastUsedAt     *time.Time   `json:"lastUsedAt"`
+}
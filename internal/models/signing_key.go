@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type SigningKey struct {
+	Base
+	KeyId      string    `json:"kid" gorm:"type:text;uniqueIndex;not null"`
+	Algorithm  string    `json:"alg" gorm:"type:text;not null"`
+	PrivateKey []byte    `json:"-" gorm:"type:bytea;not null"`
+	PublicKey  []byte    `json:"-" gorm:"type:bytea;not null"`
+	ExpiresAt  time.Time `json:"expiresAt" gorm:"index;not null"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "github.com/google/uuid"
+
 type UserType string
 
 const (
@@ -9,16 +11,58 @@ const (
 	UserTypeOrganizationUser  UserType = "organization_user"
 )
 
+func (t UserType) Valid() bool {
+	switch t {
+	case UserTypeSystemAdmin, UserTypeSystemUser, UserTypeOrganizationOwner, UserTypeOrganizationUser:
+		return true
+	}
+
+	return false
+}
+
+func (t UserType) System() bool {
+	return t == UserTypeSystemAdmin || t == UserTypeSystemUser
+}
+
+// Outranks reports whether t is a higher user type than other. From highest
+// to lowest the types are system_admin, system_user, organization_owner and
+// organization_user.
+func (t UserType) Outranks(other UserType) bool {
+	return t.rank() > other.rank()
+}
+
+func (t UserType) rank() int {
+	switch t {
+	case UserTypeSystemAdmin:
+		return 3
+	case UserTypeSystemUser:
+		return 2
+	case UserTypeOrganizationOwner:
+		return 1
+	}
+
+	return 0
+}
+
 type User struct {
 	Base
 	Name          string         `json:"name" gorm:"type:text;not null"`
 	Email         string         `json:"email" gorm:"type:text;uniqueIndex;not null"`
+	EmailVerified bool           `json:"emailVerified" gorm:"type:boolean;default:false;not null"`
+	Active        bool           `json:"active" gorm:"type:boolean;default:true;not null"`
+	ExternalId    *string        `json:"externalId" gorm:"type:text"`
+	ProvisionedBy *uuid.UUID     `json:"-" gorm:"type:uuid;index"`
 	Password      []byte         `json:"-" gorm:"type:bytea"`
 	Bio           *string        `json:"bio" gorm:"type:text"`
 	MfaEnabled    bool           `json:"mfaEnabled" gorm:"type:boolean;default:false;not null"`
-	MfaVerified   bool           `json:"mfaVerified" gorm:"type:boolean;default:false;not null"`
+	MfaVerified   bool           `json:"mfaVerified" gorm:"-"`
 	MfaSecret     []byte         `json:"-" gorm:"type:bytea"`
+	MfaLastStep   int64          `json:"-" gorm:"type:bigint;default:0;not null"`
+	TotpConfirmed bool           `json:"-" gorm:"type:boolean;default:false;not null"`
+	TotpPeriod    uint           `json:"-" gorm:"type:integer;default:0;not null"`
+	TotpDigits    int            `json:"-" gorm:"type:integer;default:0;not null"`
+	TotpAlgorithm string         `json:"-" gorm:"type:text"`
 	Type          UserType       `json:"type" gorm:"type:text;not null"`
 	Roles         []Role         `json:"-" gorm:"many2many:users_roles;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Organizations []Organization `json:"-" gorm:"many2many:organizations_users;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Organizations []Organization `json:"-" gorm:"many2many:organizations_members;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+type UserIdentity struct {
+	Base
+	UserId   uuid.UUID `json:"userId" gorm:"type:uuid;index;not null"`
+	User     User      `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Provider string    `json:"provider" gorm:"type:text;uniqueIndex:idx_user_identities_provider_subject;not null"`
+	Subject  string    `json:"subject" gorm:"type:text;uniqueIndex:idx_user_identities_provider_subject;not null"`
+	Email    string    `json:"email" gorm:"type:text"`
+}
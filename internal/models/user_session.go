@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserSession struct {
+	Base
+	SessionId  string    `json:"-" gorm:"type:text;uniqueIndex;not null"`
+	UserId     uuid.UUID `json:"userId" gorm:"type:uuid;index;not null"`
+	User       User      `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	IpAddress  string    `json:"ipAddress" gorm:"type:text"`
+	UserAgent  string    `json:"userAgent" gorm:"type:text"`
+	LastSeenAt time.Time `json:"lastSeenAt" gorm:"not null"`
+	Device     string    `json:"device" gorm:"-"`
+	Current    bool      `json:"current" gorm:"-"`
+}
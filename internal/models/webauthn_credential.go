@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type WebauthnCredential struct {
+	Base
+	UserId          uuid.UUID      `json:"userId" gorm:"type:uuid;index;not null"`
+	User            User           `json:"-" gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name            string         `json:"name" gorm:"type:text;not null"`
+	CredentialId    []byte         `json:"-" gorm:"type:bytea;uniqueIndex;not null"`
+	PublicKey       []byte         `json:"-" gorm:"type:bytea;not null"`
+	AttestationType string         `json:"attestationType" gorm:"type:text"`
+	Transports      pq.StringArray `json:"transports" gorm:"type:text[]"`
+	Flags           uint8          `json:"-" gorm:"type:smallint;not null"`
+	Aaguid          []byte         `json:"-" gorm:"type:bytea"`
+	SignCount       int64          `json:"signCount" gorm:"type:bigint;default:0;not null"`
+	CloneWarning    bool           `json:"cloneWarning" gorm:"type:boolean;default:false;not null"`
+	LastUsedAt      *time.Time     `json:"lastUsedAt"`
+}
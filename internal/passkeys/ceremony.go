@@ -0,0 +1,50 @@
+package passkeys
+
+import (
+	"errors"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+)
+
+const (
+	RegistrationKey = "webauthn_registration"
+	LoginKey        = "webauthn_login"
+	PasskeyKey      = "webauthn_passkey"
+)
+
+var ErrNoCeremony = errors.New("no webauthn ceremony in progress")
+
+func SaveCeremony(ctx fiber.Ctx, key string, data *webauthn.SessionData) error {
+	encoded, err := json.Marshal(data)
+
+	if err != nil {
+		return err
+	}
+
+	session.FromContext(ctx).Set(key, string(encoded))
+
+	return nil
+}
+
+func LoadCeremony(ctx fiber.Ctx, key string) (*webauthn.SessionData, error) {
+	session := session.FromContext(ctx)
+
+	encoded, ok := session.Get(key).(string)
+
+	session.Delete(key)
+
+	if !ok || encoded == "" {
+		return nil, ErrNoCeremony
+	}
+
+	var data webauthn.SessionData
+
+	if err := json.Unmarshal([]byte(encoded), &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
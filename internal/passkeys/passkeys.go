@@ -0,0 +1,158 @@
+package passkeys
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+type User struct {
+	user        *models.User
+	credentials []models.WebauthnCredential
+}
+
+func New() (*webauthn.WebAuthn, error) {
+	appBaseUrl := common.EnvString("APP_BASE_URL", "http://localhost:3000")
+
+	relyingPartyId := "localhost"
+
+	if parsedUrl, err := url.Parse(appBaseUrl); err == nil && parsedUrl.Hostname() != "" {
+		relyingPartyId = parsedUrl.Hostname()
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          common.EnvString("API_WEBAUTHN_RP_ID", relyingPartyId),
+		RPDisplayName: common.EnvString("API_NAME", "One REST API"),
+		RPOrigins:     []string{appBaseUrl},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login: webauthn.TimeoutConfig{
+				Enforce:    true,
+				Timeout:    5 * time.Minute,
+				TimeoutUVD: 5 * time.Minute,
+			},
+			Registration: webauthn.TimeoutConfig{
+				Enforce:    true,
+				Timeout:    5 * time.Minute,
+				TimeoutUVD: 5 * time.Minute,
+			},
+		},
+	})
+}
+
+func LoadUser(storage storage.Storage, user *models.User) (*User, error) {
+	var credentials []models.WebauthnCredential
+
+	if err := storage.Database().
+		Where("user_id = ?", user.Id).
+		Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+
+	return &User{
+		user:        user,
+		credentials: credentials,
+	}, nil
+}
+
+func LoadUserByHandle(storage storage.Storage, userHandle []byte) (*User, error) {
+	userId, err := uuid.FromBytes(userHandle)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+
+	if err := storage.Database().
+		Where("id = ?", userId).
+		First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	return LoadUser(storage, &user)
+}
+
+func (u *User) Model() *models.User {
+	return u.user
+}
+
+func (u *User) WebAuthnID() []byte {
+	return u.user.Id[:]
+}
+
+func (u *User) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *User) WebAuthnDisplayName() string {
+	return u.user.Name
+}
+
+func (u *User) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.credentials))
+
+	for i, credential := range u.credentials {
+		credentials[i] = ToCredential(credential)
+	}
+
+	return credentials
+}
+
+func ToCredential(credential models.WebauthnCredential) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, len(credential.Transports))
+
+	for i, transport := range credential.Transports {
+		transports[i] = protocol.AuthenticatorTransport(transport)
+	}
+
+	return webauthn.Credential{
+		ID:              credential.CredentialId,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transport:       transports,
+		Flags:           webauthn.NewCredentialFlags(protocol.AuthenticatorFlags(credential.Flags)),
+		Authenticator: webauthn.Authenticator{
+			AAGUID:       credential.Aaguid,
+			SignCount:    uint32(credential.SignCount),
+			CloneWarning: credential.CloneWarning,
+		},
+	}
+}
+
+func FromCredential(userId uuid.UUID, name string, credential *webauthn.Credential) models.WebauthnCredential {
+	transports := make([]string, len(credential.Transport))
+
+	for i, transport := range credential.Transport {
+		transports[i] = string(transport)
+	}
+
+	return models.WebauthnCredential{
+		UserId:          userId,
+		Name:            name,
+		CredentialId:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		Flags:           uint8(credential.Flags.ProtocolValue()),
+		Aaguid:          credential.Authenticator.AAGUID,
+		SignCount:       int64(credential.Authenticator.SignCount),
+	}
+}
+
+func RecordUse(storage storage.Storage, credential *webauthn.Credential) error {
+	return storage.Database().
+		Model(&models.WebauthnCredential{}).
+		Where("credential_id = ?", credential.ID).
+		Updates(map[string]any{
+			"sign_count":    int64(credential.Authenticator.SignCount),
+			"clone_warning": credential.Authenticator.CloneWarning,
+			"flags":         uint8(credential.Flags.ProtocolValue()),
+			"last_used_at":  time.Now(),
+		}).Error
+}
@@ -0,0 +1,76 @@
+package passwords
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func HistoryLength() int {
+	return common.EnvInt("API_PASSWORD_HISTORY", 5)
+}
+
+func Reused(db *gorm.DB, user *models.User, password string) (bool, error) {
+	if user.Password != nil {
+		valid, err := Verify(password, user.Password)
+
+		if err != nil {
+			return false, err
+		}
+
+		if valid {
+			return true, nil
+		}
+	}
+
+	if HistoryLength() <= 0 {
+		return false, nil
+	}
+
+	var history []models.PasswordHistory
+
+	if err := db.
+		Where("user_id = ?", user.Id).
+		Order("created_at DESC").
+		Limit(HistoryLength()).
+		Find(&history).Error; err != nil {
+		return false, err
+	}
+
+	for _, entry := range history {
+		valid, err := Verify(password, entry.Hash)
+
+		if err != nil {
+			continue
+		}
+
+		if valid {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func Remember(db *gorm.DB, userId uuid.UUID, hash []byte) error {
+	if HistoryLength() <= 0 {
+		return nil
+	}
+
+	if err := db.Create(&models.PasswordHistory{
+		UserId: userId,
+		Hash:   hash,
+	}).Error; err != nil {
+		return err
+	}
+
+	return db.
+		Where("user_id = ? AND id NOT IN (?)", userId, db.
+			Model(&models.PasswordHistory{}).
+			Select("id").
+			Where("user_id = ?", userId).
+			Order("created_at DESC").
+			Limit(HistoryLength())).
+		Delete(&models.PasswordHistory{}).Error
+}
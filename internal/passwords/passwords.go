@@ -0,0 +1,167 @@
+package passwords
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"golang.org/x/crypto/argon2"
+)
+
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+var DefaultParams = Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+var (
+	ErrInvalidHash         = errors.New("the encoded password hash is not in the correct format")
+	ErrIncompatibleVersion = errors.New("the encoded password hash uses an incompatible version of argon2")
+)
+
+func CurrentParams() Params {
+	return Params{
+		Memory:      uint32(common.EnvInt("API_ARGON2_MEMORY", int(DefaultParams.Memory))),
+		Iterations:  uint32(common.EnvInt("API_ARGON2_ITERATIONS", int(DefaultParams.Iterations))),
+		Parallelism: uint8(common.EnvInt("API_ARGON2_PARALLELISM", int(DefaultParams.Parallelism))),
+		SaltLength:  DefaultParams.SaltLength,
+		KeyLength:   DefaultParams.KeyLength,
+	}
+}
+
+func Hash(password string) ([]byte, error) {
+	params := CurrentParams()
+
+	salt := make([]byte, params.SaltLength)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	key := argon2.IDKey(
+		[]byte(password),
+		salt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		params.KeyLength,
+	)
+
+	encodedHash := fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+
+	return []byte(encodedHash), nil
+}
+
+func Verify(password string, encodedHash []byte) (bool, error) {
+	params, salt, key, err := decode(string(encodedHash))
+
+	if err != nil {
+		return false, err
+	}
+
+	otherKey := argon2.IDKey(
+		[]byte(password),
+		salt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		params.KeyLength,
+	)
+
+	return subtle.ConstantTimeCompare(key, otherKey) == 1, nil
+}
+
+var (
+	dummyHash     []byte
+	dummyHashOnce sync.Once
+)
+
+func VerifyDummy(password string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = Hash("dummy-password-for-timing")
+	})
+
+	if dummyHash != nil {
+		_, _ = Verify(password, dummyHash)
+	}
+}
+
+func NeedsRehash(encodedHash []byte) bool {
+	params, _, _, err := decode(string(encodedHash))
+
+	if err != nil {
+		return true
+	}
+
+	return *params != CurrentParams()
+}
+
+func decode(encodedHash string) (*Params, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	if version != argon2.Version {
+		return nil, nil, nil, ErrIncompatibleVersion
+	}
+
+	params := &Params{}
+
+	if _, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&params.Memory,
+		&params.Iterations,
+		&params.Parallelism,
+	); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.Strict().DecodeString(parts[4])
+
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	key, err := base64.RawStdEncoding.Strict().DecodeString(parts[5])
+
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,98 @@
+package passwords
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+)
+
+type PolicyError struct {
+	Message string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Message
+}
+
+var (
+	blocklist     map[string]struct{}
+	blocklistErr  error
+	blocklistOnce sync.Once
+)
+
+func MinLength() int {
+	return common.EnvInt("API_PASSWORD_MIN_LENGTH", 8)
+}
+
+func Validate(password string, email string) error {
+	if utf8.RuneCountInString(password) < MinLength() {
+		return &PolicyError{
+			Message: fmt.Sprintf("Please provide a password of at least %d characters.", MinLength()),
+		}
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(password))
+
+	if email != "" && normalized == strings.ToLower(strings.TrimSpace(email)) {
+		return &PolicyError{
+			Message: "Your password cannot be the same as your email address.",
+		}
+	}
+
+	blocked, err := loadBlocklist()
+
+	if err != nil {
+		return err
+	}
+
+	if _, ok := blocked[normalized]; ok {
+		return &PolicyError{
+			Message: "This password is too common. Please choose a different password.",
+		}
+	}
+
+	return nil
+}
+
+func loadBlocklist() (map[string]struct{}, error) {
+	blocklistOnce.Do(func() {
+		blocklist = map[string]struct{}{}
+
+		path := common.EnvString("API_PASSWORD_BLOCKLIST", "")
+
+		if path == "" {
+			return
+		}
+
+		file, err := os.Open(path)
+
+		if err != nil {
+			blocklistErr = err
+
+			return
+		}
+
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+
+		for scanner.Scan() {
+			line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			blocklist[line] = struct{}{}
+		}
+
+		blocklistErr = scanner.Err()
+	})
+
+	return blocklist, blocklistErr
+}
@@ -0,0 +1,51 @@
+package permissions
+
+import "github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+
+var baselines = map[models.UserType][]string{
+	models.UserTypeSystemAdmin: {
+		Wildcard,
+	},
+	models.UserTypeSystemUser: {
+		"permissions.list",
+		"roles.list",
+		"roles.view",
+		"organizations.list",
+		"organizations.view",
+	},
+	models.UserTypeOrganizationOwner: {
+		"permissions.list",
+		"organizations.create",
+	},
+	models.UserTypeOrganizationUser: {},
+}
+
+var organizationBaselines = map[models.UserType][]string{
+	models.UserTypeOrganizationOwner: {
+		"organizations.view",
+		"organizations.update",
+		"organizations.delete",
+		"organizations.users.**",
+		"organizations.roles.**",
+		"organizations.invitations.**",
+		"organizations.scim.**",
+	},
+	models.UserTypeOrganizationUser: {
+		"permissions.list",
+		"organizations.view",
+		"organizations.users.list",
+		"organizations.roles.list",
+	},
+}
+
+func Baseline(userType models.UserType) []string {
+	return append([]string{}, baselines[userType]...)
+}
+
+func OrganizationBaseline(userType models.UserType, owner bool) []string {
+	if userType == models.UserTypeOrganizationOwner && !owner {
+		return organizationBaselines[models.UserTypeOrganizationUser]
+	}
+
+	return organizationBaselines[userType]
+}
@@ -0,0 +1,119 @@
+package permissions
+
+import (
+	"slices"
+	"strings"
+)
+
+type Permission struct {
+	Name        string   `json:"name"`
+	Group       string   `json:"group"`
+	Description string   `json:"description"`
+	Routes      []string `json:"routes"`
+}
+
+type Group struct {
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+}
+
+type Catalog struct {
+	permissions map[string]*Permission
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{
+		permissions: map[string]*Permission{},
+	}
+}
+
+func (c *Catalog) Register(name string, description string, route string) {
+	permission, ok := c.permissions[name]
+
+	if !ok {
+		group, _, _ := strings.Cut(name, Separator)
+
+		permission = &Permission{
+			Name:   name,
+			Group:  group,
+			Routes: []string{},
+		}
+
+		c.permissions[name] = permission
+	}
+
+	if description != "" && !strings.Contains(permission.Description, description) {
+		if permission.Description == "" {
+			permission.Description = description
+		} else {
+			permission.Description += ", " + description
+		}
+	}
+
+	if !slices.Contains(permission.Routes, route) {
+		permission.Routes = append(permission.Routes, route)
+	}
+}
+
+func (c *Catalog) Groups() []Group {
+	names := make([]string, 0, len(c.permissions))
+
+	for name := range c.permissions {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	groups := []Group{}
+
+	for _, name := range names {
+		permission := c.permissions[name]
+
+		if len(groups) == 0 || groups[len(groups)-1].Name != permission.Group {
+			groups = append(groups, Group{
+				Name:        permission.Group,
+				Permissions: []Permission{},
+			})
+		}
+
+		groups[len(groups)-1].Permissions = append(groups[len(groups)-1].Permissions, *permission)
+	}
+
+	return groups
+}
+
+func (c *Catalog) Unknown(patterns []string) []string {
+	unknown := []string{}
+
+	for _, pattern := range patterns {
+		if !c.known(pattern) {
+			unknown = append(unknown, pattern)
+		}
+	}
+
+	return unknown
+}
+
+func (c *Catalog) known(pattern string) bool {
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), Deny)
+
+	if pattern == Wildcard || pattern == Recursive {
+		return true
+	}
+
+	if _, ok := c.permissions[pattern]; ok {
+		return true
+	}
+
+	if !strings.Contains(pattern, Wildcard) {
+		return false
+	}
+
+	for name := range c.permissions {
+		if Match(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+package permissions
+
+import "strings"
+
+const (
+	Separator = "."
+	Wildcard  = "*"
+	Recursive = "**"
+	Deny      = "!"
+)
+
+func Allowed(granted []string, required ...string) bool {
+	for _, permission := range required {
+		if allowed(granted, permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func allowed(granted []string, permission string) bool {
+	matched := false
+
+	for _, pattern := range granted {
+		pattern = strings.TrimSpace(pattern)
+
+		if denied, found := strings.CutPrefix(pattern, Deny); found {
+			if Match(denied, permission) {
+				return false
+			}
+
+			continue
+		}
+
+		if !matched && Match(pattern, permission) {
+			matched = true
+		}
+	}
+
+	return matched
+}
+
+func Match(pattern string, permission string) bool {
+	if pattern == "" || permission == "" {
+		return false
+	}
+
+	if pattern == Wildcard {
+		pattern = Recursive
+	}
+
+	return match(
+		strings.Split(pattern, Separator),
+		strings.Split(permission, Separator),
+	)
+}
+
+func match(pattern []string, permission []string) bool {
+	if len(pattern) == 0 {
+		return len(permission) == 0
+	}
+
+	if pattern[0] == Recursive {
+		if match(pattern[1:], permission) {
+			return true
+		}
+
+		return len(permission) > 0 && match(pattern, permission[1:])
+	}
+
+	if len(permission) == 0 || permission[0] == "" {
+		return false
+	}
+
+	switch pattern[0] {
+	case Wildcard:
+		if permission[0] == Recursive {
+			return false
+		}
+	case permission[0]:
+	default:
+		return false
+	}
+
+	return match(pattern[1:], permission[1:])
+}
+
+// Grantable reports whether every permission in permissions is already covered
+// by granted, so that holding granted is enough to hand them to someone else.
+// A pattern is not grantable if it would cover anything granted denies.
+func Grantable(granted []string, permissions []string) bool {
+	for _, permission := range permissions {
+		permission = strings.TrimSpace(permission)
+
+		if _, found := strings.CutPrefix(permission, Deny); found {
+			continue
+		}
+
+		if !allowed(granted, permission) {
+			return false
+		}
+
+		for _, pattern := range granted {
+			if denied, found := strings.CutPrefix(strings.TrimSpace(pattern), Deny); found && Match(permission, denied) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
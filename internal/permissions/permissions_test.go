@@ -0,0 +1,110 @@
+package permissions
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		pattern    string
+		permission string
+		want       bool
+	}{
+		{"exact", "users.list", "users.list", true},
+		{"different leaf", "users.list", "users.delete", false},
+		{"segment prefix is not a match", "users", "users.delete", false},
+		{"partial segment is not a match", "u", "users.list", false},
+		{"longer permission with same prefix", "roles.list", "roles.listx", false},
+		{"longer pattern", "users.list.all", "users.list", false},
+		{"single wildcard", "users.*", "users.delete", true},
+		{"single wildcard needs a segment", "users.*", "users", false},
+		{"single wildcard spans one segment", "users.*", "users.roles.assign", false},
+		{"single wildcard in the middle", "organizations.*.list", "organizations.users.list", true},
+		{"single wildcard in the middle mismatch", "organizations.*.list", "organizations.users.assign", false},
+		{"recursive wildcard", "users.**", "users.roles.assign", true},
+		{"recursive wildcard matches one segment", "users.**", "users.list", true},
+		{"recursive wildcard matches zero segments", "users.**", "users", true},
+		{"recursive wildcard in the middle", "organizations.**.list", "organizations.scim.tokens.list", true},
+		{"recursive wildcard in the middle mismatch", "organizations.**.list", "organizations.scim.tokens.create", false},
+		{"recursive wildcard different root", "users.**", "roles.list", false},
+		{"bare recursive wildcard", "**", "security.lockouts.unlock", true},
+		{"bare wildcard grants everything", "*", "organizations.users.assign", true},
+		{"empty pattern", "", "users.list", false},
+		{"empty permission", "users.*", "", false},
+		{"empty segment", "users.*", "users.", false},
+		{"wildcard covers wildcard scope", "users.*", "users.*", true},
+		{"recursive covers wildcard scope", "users.**", "users.*", true},
+		{"wildcard does not cover recursive scope", "users.*", "users.**", false},
+		{"literal does not cover wildcard scope", "users.list", "users.*", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Match(test.pattern, test.permission); got != test.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", test.pattern, test.permission, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		want     bool
+	}{
+		{"nothing granted", nil, []string{"users.list"}, false},
+		{"nothing required", []string{"*"}, nil, false},
+		{"granted exactly", []string{"users.list"}, []string{"users.list"}, true},
+		{"any required permission is enough", []string{"roles.view"}, []string{"roles.list", "roles.view"}, true},
+		{"none of the required permissions", []string{"roles.view"}, []string{"users.list", "users.view"}, false},
+		{"prefix grants nothing", []string{"users"}, []string{"users.delete"}, false},
+		{"deny overrides wildcard", []string{"users.*", "!users.delete"}, []string{"users.delete"}, false},
+		{"deny leaves siblings", []string{"users.*", "!users.delete"}, []string{"users.list"}, true},
+		{"deny order does not matter", []string{"!users.delete", "users.*"}, []string{"users.delete"}, false},
+		{"deny overrides everything", []string{"*", "!security.**"}, []string{"security.lockouts.unlock"}, false},
+		{"deny with wildcard", []string{"**", "!organizations.*.delete"}, []string{"organizations.scim.delete"}, false},
+		{"deny alone grants nothing", []string{"!users.delete"}, []string{"users.list"}, false},
+		{"denied permission falls back to another required", []string{"users.*", "!users.delete"}, []string{"users.delete", "users.view"}, true},
+		{"surrounding whitespace", []string{" users.list "}, []string{"users.list"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Allowed(test.granted, test.required...); got != test.want {
+				t.Errorf("Allowed(%q, %q) = %v, want %v", test.granted, test.required, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGrantable(t *testing.T) {
+	tests := []struct {
+		name        string
+		granted     []string
+		permissions []string
+		want        bool
+	}{
+		{"nothing to grant", nil, nil, true},
+		{"nothing granted", nil, []string{"users.list"}, false},
+		{"granted exactly", []string{"users.list", "users.view"}, []string{"users.list", "users.view"}, true},
+		{"every permission is needed", []string{"users.list"}, []string{"users.list", "users.delete"}, false},
+		{"covered by wildcard", []string{"users.*"}, []string{"users.delete"}, true},
+		{"wildcard needs wildcard", []string{"users.list"}, []string{"users.*"}, false},
+		{"recursive covers wildcard", []string{"users.**"}, []string{"users.*"}, true},
+		{"wildcard does not cover recursive", []string{"users.*"}, []string{"users.**"}, false},
+		{"denied permission", []string{"users.*", "!users.delete"}, []string{"users.delete"}, false},
+		{"wildcard over a denied permission", []string{"users.*", "!users.delete"}, []string{"users.*"}, false},
+		{"everything over a denied permission", []string{"*", "!security.**"}, []string{"*"}, false},
+		{"sibling of a denied permission", []string{"users.*", "!users.delete"}, []string{"users.list"}, true},
+		{"denies can always be granted", nil, []string{"!users.delete"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Grantable(test.granted, test.permissions); got != test.want {
+				t.Errorf("Grantable(%q, %q) = %v, want %v", test.granted, test.permissions, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package permissions
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Evaluation struct {
+	OrganizationId *uuid.UUID
+	Permissions    []string
+}
+
+func Evaluate(db *gorm.DB, user *models.User, organizationId *uuid.UUID) (*Evaluation, error) {
+	evaluation := &Evaluation{
+		Permissions: Baseline(user.Type),
+	}
+
+	if organizationId != nil {
+		if user.Type == models.UserTypeSystemAdmin {
+			evaluation.OrganizationId = organizationId
+		} else {
+			member, err := Member(db, user.Id, *organizationId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if member {
+				owner, err := Owner(db, user.Id, *organizationId)
+
+				if err != nil {
+					return nil, err
+				}
+
+				evaluation.OrganizationId = organizationId
+				evaluation.Permissions = append(evaluation.Permissions, OrganizationBaseline(user.Type, owner)...)
+			}
+		}
+	}
+
+	confined := user.Type == models.UserTypeOrganizationUser && evaluation.OrganizationId == nil
+
+	for _, role := range user.Roles {
+		if role.OrganizationId == nil {
+			if !confined {
+				evaluation.Permissions = append(evaluation.Permissions, role.Permissions...)
+			}
+
+			continue
+		}
+
+		if evaluation.OrganizationId != nil && *role.OrganizationId == *evaluation.OrganizationId {
+			evaluation.Permissions = append(evaluation.Permissions, role.Permissions...)
+		}
+	}
+
+	return evaluation, nil
+}
+
+func Member(db *gorm.DB, userId uuid.UUID, organizationId uuid.UUID) (bool, error) {
+	var count int64
+
+	if err := db.
+		Model(&models.Organization{}).
+		Where("id = ? AND (owner_id = ? OR EXISTS (SELECT 1 FROM organizations_members WHERE organization_id = organizations.id AND user_id = ?))", organizationId, userId, userId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func Owner(db *gorm.DB, userId uuid.UUID, organizationId uuid.UUID) (bool, error) {
+	var count int64
+
+	if err := db.
+		Model(&models.Organization{}).
+		Where("id = ? AND owner_id = ?", organizationId, userId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func Organizations(db *gorm.DB, userId uuid.UUID) ([]uuid.UUID, error) {
+	organizationIds := []uuid.UUID{}
+
+	if err := db.
+		Model(&models.Organization{}).
+		Where("owner_id = ? OR EXISTS (SELECT 1 FROM organizations_members WHERE organization_id = organizations.id AND user_id = ?)", userId, userId).
+		Pluck("id", &organizationIds).Error; err != nil {
+		return nil, err
+	}
+
+	return organizationIds, nil
+}
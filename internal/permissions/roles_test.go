@@ -0,0 +1,147 @@
+package permissions
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type membership struct {
+	member bool
+	owner  bool
+}
+
+// newMembershipDatabase answers the membership and ownership counts Evaluate
+// runs against the organizations table.
+func newMembershipDatabase(t *testing.T, membership membership) *gorm.DB {
+	t.Helper()
+
+	return testutil.Fake(t, &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			switch {
+			case statement.Contains("organizations_members"):
+				if membership.member || membership.owner {
+					return testutil.Count(1)
+				}
+			case statement.Contains("owner_id"):
+				if membership.owner {
+					return testutil.Count(1)
+				}
+			}
+
+			return testutil.Count(0)
+		},
+	}).Database()
+}
+
+func TestEvaluate(t *testing.T) {
+	organizationId := uuid.New()
+	otherOrganizationId := uuid.New()
+
+	roles := []models.Role{
+		{Name: "Global", Permissions: []string{"global.view"}},
+		{Name: "Owned", Permissions: []string{"owned.view"}, OrganizationId: &organizationId},
+		{Name: "Other", Permissions: []string{"other.view"}, OrganizationId: &otherOrganizationId},
+	}
+
+	tests := []struct {
+		name           string
+		userType       models.UserType
+		membership     membership
+		organizationId *uuid.UUID
+		evaluated      *uuid.UUID
+		include        []string
+		exclude        []string
+	}{
+		{
+			name:     "system user without an organization gets global roles only",
+			userType: models.UserTypeSystemUser,
+			include:  []string{"roles.list", "global.view"},
+			exclude:  []string{"owned.view", "other.view"},
+		},
+		{
+			name:     "organization user without an organization is confined",
+			userType: models.UserTypeOrganizationUser,
+			exclude:  []string{"global.view", "owned.view", "other.view"},
+		},
+		{
+			name:           "member gets global roles and roles owned by the organization",
+			userType:       models.UserTypeOrganizationUser,
+			membership:     membership{member: true},
+			organizationId: &organizationId,
+			evaluated:      &organizationId,
+			include:        []string{"organizations.view", "global.view", "owned.view"},
+			exclude:        []string{"other.view", "organizations.update"},
+		},
+		{
+			name:           "owner gets the owner baseline",
+			userType:       models.UserTypeOrganizationOwner,
+			membership:     membership{owner: true},
+			organizationId: &organizationId,
+			evaluated:      &organizationId,
+			include:        []string{"organizations.update", "owned.view"},
+			exclude:        []string{"other.view"},
+		},
+		{
+			name:           "owner account that only joined gets the member baseline",
+			userType:       models.UserTypeOrganizationOwner,
+			membership:     membership{member: true},
+			organizationId: &organizationId,
+			evaluated:      &organizationId,
+			include:        []string{"organizations.view", "owned.view"},
+			exclude:        []string{"organizations.update"},
+		},
+		{
+			name:           "non-member does not get owned roles",
+			userType:       models.UserTypeOrganizationUser,
+			organizationId: &organizationId,
+			exclude:        []string{"global.view", "owned.view", "organizations.view"},
+		},
+		{
+			name:           "system admin gets roles owned by the selected organization",
+			userType:       models.UserTypeSystemAdmin,
+			organizationId: &organizationId,
+			evaluated:      &organizationId,
+			include:        []string{Wildcard, "global.view", "owned.view"},
+			exclude:        []string{"other.view"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := newMembershipDatabase(t, test.membership)
+			user := &models.User{
+				Base:  models.Base{Id: uuid.New()},
+				Type:  test.userType,
+				Roles: roles,
+			}
+
+			evaluation, err := Evaluate(database, user, test.organizationId)
+
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+
+			if (evaluation.OrganizationId == nil) != (test.evaluated == nil) ||
+				(evaluation.OrganizationId != nil && *evaluation.OrganizationId != *test.evaluated) {
+				t.Errorf("OrganizationId = %v, want %v", evaluation.OrganizationId, test.evaluated)
+			}
+
+			for _, permission := range test.include {
+				if !slices.Contains(evaluation.Permissions, permission) {
+					t.Errorf("Permissions = %v, missing %s", evaluation.Permissions, permission)
+				}
+			}
+
+			for _, permission := range test.exclude {
+				if slices.Contains(evaluation.Permissions, permission) {
+					t.Errorf("Permissions = %v, should not contain %s", evaluation.Permissions, permission)
+				}
+			}
+		})
+	}
+}
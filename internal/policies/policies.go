@@ -0,0 +1,223 @@
+package policies
+
+import (
+	"errors"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Request struct {
+	User           *models.User
+	Target         *Target
+	OrganizationId *uuid.UUID
+}
+
+type rule func(evaluation *evaluation) (bool, error)
+
+var rules = map[models.PolicyType]rule{
+	models.PolicySystemAdmin:       systemAdmin,
+	models.PolicySelf:              self,
+	models.PolicyOrganizationOwner: organizationOwner,
+	models.PolicySameOrganization:  sameOrganization,
+}
+
+type evaluation struct {
+	db            *gorm.DB
+	request       Request
+	row           map[string]any
+	rowLoaded     bool
+	organizations []uuid.UUID
+	resolved      bool
+}
+
+func Evaluate(db *gorm.DB, request Request, policies ...models.PolicyType) (bool, error) {
+	evaluation := &evaluation{
+		db:      db,
+		request: request,
+	}
+
+	for _, policy := range policies {
+		rule, ok := rules[policy]
+
+		if !ok {
+			continue
+		}
+
+		satisfied, err := rule(evaluation)
+
+		if err != nil {
+			return false, err
+		}
+
+		if satisfied {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func systemAdmin(evaluation *evaluation) (bool, error) {
+	return evaluation.request.User.Type == models.UserTypeSystemAdmin, nil
+}
+
+func self(evaluation *evaluation) (bool, error) {
+	target := evaluation.request.Target
+
+	if target == nil || target.Id == "" {
+		return false, nil
+	}
+
+	if target.Table == "users" {
+		return target.Id == evaluation.request.User.Id.String(), nil
+	}
+
+	row, err := evaluation.target()
+
+	if err != nil || row == nil {
+		return false, err
+	}
+
+	return sameId(row["user_id"], evaluation.request.User.Id), nil
+}
+
+func organizationOwner(evaluation *evaluation) (bool, error) {
+	organizationIds, err := evaluation.targetOrganizations()
+
+	if err != nil || len(organizationIds) == 0 {
+		return false, err
+	}
+
+	var count int64
+
+	if err := evaluation.db.
+		Model(&models.Organization{}).
+		Where("id IN ? AND owner_id = ?", organizationIds, evaluation.request.User.Id).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func sameOrganization(evaluation *evaluation) (bool, error) {
+	organizationIds, err := evaluation.targetOrganizations()
+
+	if err != nil || len(organizationIds) == 0 {
+		return false, err
+	}
+
+	var count int64
+
+	if err := evaluation.db.
+		Model(&models.Organization{}).
+		Where("id IN ? AND (owner_id = ? OR EXISTS (SELECT 1 FROM organizations_members WHERE organization_id = organizations.id AND user_id = ?))", organizationIds, evaluation.request.User.Id, evaluation.request.User.Id).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (e *evaluation) target() (map[string]any, error) {
+	if e.rowLoaded {
+		return e.row, nil
+	}
+
+	e.rowLoaded = true
+
+	target := e.request.Target
+
+	if target == nil || target.Id == "" {
+		return nil, nil
+	}
+
+	if _, err := uuid.Parse(target.Id); err != nil {
+		return nil, nil
+	}
+
+	row := map[string]any{}
+
+	if err := e.db.Table(target.Table).Where("id = ?", target.Id).Take(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	e.row = row
+
+	return e.row, nil
+}
+
+func (e *evaluation) targetOrganizations() ([]uuid.UUID, error) {
+	if e.resolved {
+		return e.organizations, nil
+	}
+
+	e.resolved = true
+
+	target := e.request.Target
+
+	if target == nil || target.Id == "" {
+		if e.request.OrganizationId != nil {
+			e.organizations = []uuid.UUID{*e.request.OrganizationId}
+		}
+
+		return e.organizations, nil
+	}
+
+	row, err := e.target()
+
+	if err != nil || row == nil {
+		return nil, err
+	}
+
+	switch {
+	case target.Table == "organizations":
+		if id, ok := toUuid(row["id"]); ok {
+			e.organizations = []uuid.UUID{id}
+		}
+	case target.Table == "users":
+		if err := e.db.
+			Model(&models.Organization{}).
+			Where("owner_id = ? OR EXISTS (SELECT 1 FROM organizations_members WHERE organization_id = organizations.id AND user_id = ?)", target.Id, target.Id).
+			Pluck("id", &e.organizations).Error; err != nil {
+			return nil, err
+		}
+	default:
+		if id, ok := toUuid(row["organization_id"]); ok {
+			e.organizations = []uuid.UUID{id}
+		}
+	}
+
+	return e.organizations, nil
+}
+
+func sameId(value any, id uuid.UUID) bool {
+	parsed, ok := toUuid(value)
+
+	return ok && parsed == id
+}
+
+func toUuid(value any) (uuid.UUID, bool) {
+	switch typed := value.(type) {
+	case string:
+		parsed, err := uuid.Parse(typed)
+
+		return parsed, err == nil
+	case []byte:
+		parsed, err := uuid.ParseBytes(typed)
+
+		return parsed, err == nil
+	case [16]byte:
+		return uuid.UUID(typed), true
+	case uuid.UUID:
+		return typed, true
+	}
+
+	return uuid.Nil, false
+}
@@ -0,0 +1,302 @@
+package policies
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/testutil"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// fixture describes the rows and memberships the policy rules look up.
+type fixture struct {
+	rows          map[string]map[string]any
+	organizations []uuid.UUID
+	owner         bool
+	member        bool
+}
+
+// newPolicyStorage answers the target row lookups and organization counts
+// the policy rules run from fixture.
+func newPolicyStorage(t *testing.T, fixture fixture) (*testutil.Storage, *testutil.Database) {
+	t.Helper()
+
+	database := &testutil.Database{
+		Query: func(statement testutil.Statement) *testutil.Rows {
+			switch {
+			case statement.Contains("count(*)"):
+				if fixture.owner || (fixture.member && statement.Contains("organizations_members")) {
+					return testutil.Count(1)
+				}
+
+				return testutil.Count(0)
+			case statement.Contains(`SELECT "id" FROM "organizations"`):
+				organizationIds := []any{}
+
+				for _, organizationId := range fixture.organizations {
+					organizationIds = append(organizationIds, organizationId.String())
+				}
+
+				return testutil.Column("id", organizationIds...)
+			}
+
+			for table, row := range fixture.rows {
+				if statement.Contains(`FROM "` + table + `"`) {
+					return testutil.Row(row)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return testutil.Fake(t, database), database
+}
+
+func TestEvaluate(t *testing.T) {
+	userId := uuid.New()
+	otherUserId := uuid.New()
+	organizationId := uuid.New()
+	roleId := uuid.New()
+
+	tests := []struct {
+		name           string
+		userType       models.UserType
+		fixture        fixture
+		target         *Target
+		organizationId *uuid.UUID
+		policies       []models.PolicyType
+		want           bool
+	}{
+		{
+			name:     "system admin",
+			userType: models.UserTypeSystemAdmin,
+			policies: []models.PolicyType{models.PolicySystemAdmin},
+			want:     true,
+		},
+		{
+			name:     "system admin policy rejects other users",
+			userType: models.UserTypeSystemUser,
+			policies: []models.PolicyType{models.PolicySystemAdmin},
+		},
+		{
+			name:     "no policies",
+			userType: models.UserTypeSystemAdmin,
+		},
+		{
+			name:     "unknown policies are ignored",
+			userType: models.UserTypeSystemAdmin,
+			policies: []models.PolicyType{"unknown"},
+		},
+		{
+			name:     "self on the user itself",
+			userType: models.UserTypeOrganizationUser,
+			target:   &Target{Table: "users", Id: userId.String()},
+			policies: []models.PolicyType{models.PolicySelf},
+			want:     true,
+		},
+		{
+			name:     "self on another user",
+			userType: models.UserTypeOrganizationUser,
+			target:   &Target{Table: "users", Id: otherUserId.String()},
+			policies: []models.PolicyType{models.PolicySelf},
+		},
+		{
+			name:     "self on a row the user owns",
+			userType: models.UserTypeOrganizationUser,
+			fixture:  fixture{rows: map[string]map[string]any{"sessions": {"id": roleId.String(), "user_id": userId.String()}}},
+			target:   &Target{Table: "sessions", Id: roleId.String()},
+			policies: []models.PolicyType{models.PolicySelf},
+			want:     true,
+		},
+		{
+			name:     "self on a row another user owns",
+			userType: models.UserTypeOrganizationUser,
+			fixture:  fixture{rows: map[string]map[string]any{"sessions": {"id": roleId.String(), "user_id": otherUserId.String()}}},
+			target:   &Target{Table: "sessions", Id: roleId.String()},
+			policies: []models.PolicyType{models.PolicySelf},
+		},
+		{
+			name:     "self on a missing row",
+			userType: models.UserTypeOrganizationUser,
+			target:   &Target{Table: "sessions", Id: roleId.String()},
+			policies: []models.PolicyType{models.PolicySelf},
+		},
+		{
+			name:     "self without a target",
+			userType: models.UserTypeOrganizationUser,
+			policies: []models.PolicyType{models.PolicySelf},
+		},
+		{
+			name:           "organization owner of the active organization",
+			userType:       models.UserTypeOrganizationOwner,
+			fixture:        fixture{owner: true},
+			organizationId: &organizationId,
+			policies:       []models.PolicyType{models.PolicyOrganizationOwner},
+			want:           true,
+		},
+		{
+			name:           "member is not the organization owner",
+			userType:       models.UserTypeOrganizationUser,
+			fixture:        fixture{member: true},
+			organizationId: &organizationId,
+			policies:       []models.PolicyType{models.PolicyOrganizationOwner},
+		},
+		{
+			name:     "organization owner without an organization",
+			userType: models.UserTypeOrganizationOwner,
+			fixture:  fixture{owner: true},
+			policies: []models.PolicyType{models.PolicyOrganizationOwner},
+		},
+		{
+			name:     "organization owner of the target organization",
+			userType: models.UserTypeOrganizationOwner,
+			fixture: fixture{
+				owner: true,
+				rows:  map[string]map[string]any{"organizations": {"id": organizationId.String()}},
+			},
+			target:   &Target{Table: "organizations", Id: organizationId.String()},
+			policies: []models.PolicyType{models.PolicyOrganizationOwner},
+			want:     true,
+		},
+		{
+			name:     "same organization as the target row",
+			userType: models.UserTypeOrganizationUser,
+			fixture: fixture{
+				member: true,
+				rows:   map[string]map[string]any{"roles": {"id": roleId.String(), "organization_id": organizationId.String()}},
+			},
+			target:   &Target{Table: "roles", Id: roleId.String()},
+			policies: []models.PolicyType{models.PolicySameOrganization},
+			want:     true,
+		},
+		{
+			name:     "target row without an organization",
+			userType: models.UserTypeOrganizationUser,
+			fixture: fixture{
+				member: true,
+				rows:   map[string]map[string]any{"roles": {"id": roleId.String(), "organization_id": nil}},
+			},
+			target:   &Target{Table: "roles", Id: roleId.String()},
+			policies: []models.PolicyType{models.PolicySameOrganization},
+		},
+		{
+			name:     "same organization as the target user",
+			userType: models.UserTypeOrganizationUser,
+			fixture: fixture{
+				member:        true,
+				organizations: []uuid.UUID{organizationId},
+				rows:          map[string]map[string]any{"users": {"id": otherUserId.String()}},
+			},
+			target:   &Target{Table: "users", Id: otherUserId.String()},
+			policies: []models.PolicyType{models.PolicySameOrganization},
+			want:     true,
+		},
+		{
+			name:     "target user in no organization",
+			userType: models.UserTypeOrganizationUser,
+			fixture: fixture{
+				member: true,
+				rows:   map[string]map[string]any{"users": {"id": otherUserId.String()}},
+			},
+			target:   &Target{Table: "users", Id: otherUserId.String()},
+			policies: []models.PolicyType{models.PolicySameOrganization},
+		},
+		{
+			name:           "not a member of the organization",
+			userType:       models.UserTypeOrganizationUser,
+			organizationId: &organizationId,
+			policies:       []models.PolicyType{models.PolicySameOrganization},
+		},
+		{
+			name:           "later policies are tried when earlier ones fail",
+			userType:       models.UserTypeOrganizationUser,
+			fixture:        fixture{member: true},
+			organizationId: &organizationId,
+			policies:       []models.PolicyType{models.PolicySystemAdmin, models.PolicyOrganizationOwner, models.PolicySameOrganization},
+			want:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storage, _ := newPolicyStorage(t, test.fixture)
+
+			satisfied, err := Evaluate(storage.Database(), Request{
+				User:           &models.User{Base: models.Base{Id: userId}, Type: test.userType},
+				Target:         test.target,
+				OrganizationId: test.organizationId,
+			}, test.policies...)
+
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+
+			if satisfied != test.want {
+				t.Errorf("Evaluate() = %v, want %v", satisfied, test.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateIgnoresMalformedTargetIds(t *testing.T) {
+	storage, database := newPolicyStorage(t, fixture{owner: true, member: true})
+
+	satisfied, err := Evaluate(storage.Database(), Request{
+		User:   &models.User{Base: models.Base{Id: uuid.New()}, Type: models.UserTypeOrganizationUser},
+		Target: &Target{Table: "roles", Id: "not-a-uuid"},
+	}, models.PolicySelf, models.PolicyOrganizationOwner, models.PolicySameOrganization)
+
+	if err != nil || satisfied {
+		t.Errorf("Evaluate() = %v, %v, want false", satisfied, err)
+	}
+
+	if statements := database.Statements(); len(statements) != 0 {
+		t.Errorf("statements = %v, want none for a malformed id", statements)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	storage := testutil.Fake(t, &testutil.Database{})
+	roleId := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		handler fiber.Handler
+		path    string
+		want    Target
+	}{
+		{"with a parameter", Resolve[models.Role](storage, "id"), "/roles/" + roleId, Target{Table: "roles", Id: roleId}},
+		{"without a parameter", Resolve[models.Organization](storage, ""), "/roles/" + roleId, Target{Table: "organizations"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var target *Target
+
+			app := fiber.New()
+
+			app.Get("/roles/:id", test.handler, func(ctx fiber.Ctx) error {
+				target, _ = ctx.Locals(TargetKey).(*Target)
+
+				return ctx.SendStatus(fiber.StatusOK)
+			})
+
+			response, err := app.Test(httptest.NewRequest(fiber.MethodGet, test.path, nil))
+
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if response.StatusCode != fiber.StatusOK {
+				t.Fatalf("status = %d, want %d", response.StatusCode, fiber.StatusOK)
+			}
+
+			if target == nil || *target != test.want {
+				t.Errorf("target = %v, want %v", target, test.want)
+			}
+		})
+	}
+}
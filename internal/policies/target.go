@@ -0,0 +1,44 @@
+package policies
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/log"
+	"gorm.io/gorm"
+)
+
+const TargetKey = "policy_target"
+
+type Target struct {
+	Table string
+	Id    string
+}
+
+func Resolve[Entity any](storage storage.Storage, param string) fiber.Handler {
+	return func(ctx fiber.Ctx) error {
+		var entity Entity
+
+		statement := &gorm.Statement{DB: storage.Database()}
+
+		if err := statement.Parse(&entity); err != nil {
+			log.Errorf("🔥 Failed to resolve policy target: %s", err.Error())
+
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Internal Server Error",
+				"message": err.Error(),
+			})
+		}
+
+		target := &Target{
+			Table: statement.Schema.Table,
+		}
+
+		if param != "" {
+			target.Id = ctx.Params(param)
+		}
+
+		ctx.Locals(TargetKey, target)
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,93 @@
+package recovery
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/tokens"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const CodeCount = 10
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func Generate(storage storage.Storage, userId uuid.UUID) ([]string, error) {
+	codes := make([]string, CodeCount)
+	recoveryCodes := make([]models.MfaRecoveryCode, CodeCount)
+
+	for i := range codes {
+		buffer := make([]byte, 7)
+
+		if _, err := rand.Read(buffer); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(encoding.EncodeToString(buffer))[:10]
+
+		codes[i] = code[:5] + "-" + code[5:]
+		recoveryCodes[i] = models.MfaRecoveryCode{
+			UserId:   userId,
+			CodeHash: tokens.Hash(code),
+		}
+	}
+
+	if err := storage.Database().Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("user_id = ?", userId).
+			Delete(&models.MfaRecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&recoveryCodes).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func Consume(storage storage.Storage, userId uuid.UUID, code string) (bool, error) {
+	result := storage.Database().
+		Model(&models.MfaRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, tokens.Hash(normalize(code))).
+		Update("used_at", time.Now())
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func Remaining(storage storage.Storage, userId uuid.UUID) (int64, error) {
+	var remaining int64
+
+	if err := storage.Database().
+		Model(&models.MfaRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userId).
+		Count(&remaining).Error; err != nil {
+		return 0, err
+	}
+
+	return remaining, nil
+}
+
+func Clear(storage storage.Storage, userId uuid.UUID) error {
+	return storage.Database().
+		Where("user_id = ?", userId).
+		Delete(&models.MfaRecoveryCode{}).Error
+}
+
+func normalize(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+
+	return code
+}
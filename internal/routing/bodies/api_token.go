@@ -0,0 +1,33 @@
+package bodies
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var CreateApiTokenSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"name": {
+							Value: openapi3.NewStringSchema().
+								WithFormat("text"),
+						},
+						"scopes": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewStringSchema()),
+						},
+						"expiresAt": {
+							Value: openapi3.NewDateTimeSchema(),
+						},
+					},
+					Required: []string{
+						"name",
+						"scopes",
+					},
+				}),
+		},
+		Description: "The payload to create a personal access token.",
+		Required:    true,
+	},
+}
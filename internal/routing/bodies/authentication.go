@@ -0,0 +1,378 @@
+package bodies
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var LoginSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+						"password": {
+							Value: openapi3.NewStringSchema().WithFormat("password"),
+						},
+					},
+					Required: []string{
+						"email",
+						"password",
+					},
+				}),
+		},
+		Description: "The payload to log in with an email and password.",
+		Required:    true,
+	},
+}
+
+var RegisterSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"name": {
+							Value: openapi3.NewStringSchema().WithFormat("text").WithMin(3),
+						},
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+						"password": {
+							Value: openapi3.NewStringSchema().WithFormat("password").WithMinLength(8),
+						},
+					},
+					Required: []string{
+						"name",
+						"email",
+						"password",
+					},
+				}),
+		},
+		Description: "The payload to register a new account.",
+		Required:    true,
+	},
+}
+
+var VerifyEmailSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"token": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"token",
+					},
+				}),
+		},
+		Description: "The payload to verify an email address.",
+		Required:    true,
+	},
+}
+
+var ResendVerificationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+					},
+					Required: []string{
+						"email",
+					},
+				}),
+		},
+		Description: "The payload to resend an email verification link.",
+		Required:    true,
+	},
+}
+
+var RequestPasswordResetSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+					},
+					Required: []string{
+						"email",
+					},
+				}),
+		},
+		Description: "The payload to request a password reset link.",
+		Required:    true,
+	},
+}
+
+var ConfirmPasswordResetSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"token": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"password": {
+							Value: openapi3.NewStringSchema().WithFormat("password").WithMinLength(8),
+						},
+					},
+					Required: []string{
+						"token",
+						"password",
+					},
+				}),
+		},
+		Description: "The payload to choose a new password with a reset token.",
+		Required:    true,
+	},
+}
+
+var ChangePasswordSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"currentPassword": {
+							Value: openapi3.NewStringSchema().WithFormat("password"),
+						},
+						"newPassword": {
+							Value: openapi3.NewStringSchema().WithFormat("password").WithMinLength(8),
+						},
+					},
+					Required: []string{
+						"currentPassword",
+						"newPassword",
+					},
+				}),
+		},
+		Description: "The payload to change the current user's password.",
+		Required:    true,
+	},
+}
+
+var RequestPasswordlessSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+					},
+					Required: []string{
+						"email",
+					},
+				}),
+		},
+		Description: "The payload to request a passwordless sign-in link and code.",
+		Required:    true,
+	},
+}
+
+var VerifyPasswordlessLinkSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"token": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"token",
+					},
+				}),
+		},
+		Description: "The payload to log in with the token from a passwordless sign-in link.",
+		Required:    true,
+	},
+}
+
+var VerifyPasswordlessCodeSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"code": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"code",
+					},
+				}),
+		},
+		Description: "The payload to log in with the numeric code from a passwordless sign-in email.",
+		Required:    true,
+	},
+}
+
+var VerifyRecoveryCodeSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"code": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"code",
+					},
+				}),
+		},
+		Description: "The payload to complete Multi-Factor Authentication with a recovery code.",
+		Required:    true,
+	},
+}
+
+var WebauthnCredentialSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"id": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"rawId": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"type": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"response": {
+							Value: openapi3.NewObjectSchema(),
+						},
+					},
+					Required: []string{
+						"id",
+						"rawId",
+						"type",
+						"response",
+					},
+				}),
+		},
+		Description: "The public key credential returned by the browser's WebAuthn API, encoded as JSON.",
+		Required:    true,
+	},
+}
+
+var StartImpersonationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"reason": {
+							Value: openapi3.NewStringSchema().
+								WithFormat("text"),
+						},
+					},
+					Required: []string{},
+				}),
+		},
+		Description: "The payload to start impersonating a user, with an optional reason for the audit log.",
+		Required:    false,
+	},
+}
+
+var RefreshTokenSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"refreshToken": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"refreshToken",
+					},
+				}),
+		},
+		Description: "The payload containing a refresh token issued by the token endpoint.",
+		Required:    true,
+	},
+}
+
+var PasswordGrantSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().WithFormat("email"),
+						},
+						"password": {
+							Value: openapi3.NewStringSchema().WithFormat("password"),
+						},
+						"code": {
+							Value: openapi3.NewStringSchema(),
+						},
+					},
+					Required: []string{
+						"email",
+						"password",
+					},
+				}),
+		},
+		Description: "The payload to exchange an email and password, plus a TOTP or recovery code when MFA is enabled, for an access token and refresh token.",
+		Required:    true,
+	},
+}
+
+var SwitchOrganizationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"organizationId": {
+							Value: openapi3.NewUUIDSchema().
+								WithNullable(),
+						},
+					},
+					Required: []string{
+						"organizationId",
+					},
+				}),
+		},
+		Description: "The payload to choose the organization whose roles apply to this session.",
+		Required:    true,
+	},
+}
@@ -0,0 +1,117 @@
+package bodies
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var CreateOrganizationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"name": {
+							Value: openapi3.NewStringSchema().WithFormat("text").WithMin(3),
+						},
+						"domain": {
+							Value: openapi3.NewStringSchema().WithFormat("hostname"),
+						},
+						"ownerId": {
+							Value: openapi3.NewUUIDSchema(),
+						},
+						"requireMfa": {
+							Value: openapi3.NewBoolSchema(),
+						},
+					},
+					Required: []string{
+						"name",
+						"domain",
+						"ownerId",
+					},
+				}),
+		},
+		Description: "The payload to create a new organization.",
+		Required:    true,
+	},
+}
+
+var UpdateOrganizationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"name": {
+							Value: openapi3.NewStringSchema().WithFormat("text").WithMin(3),
+						},
+						"domain": {
+							Value: openapi3.NewStringSchema().WithFormat("hostname"),
+						},
+						"requireMfa": {
+							Value: openapi3.NewBoolSchema(),
+						},
+					},
+					Required: []string{},
+				}),
+		},
+		Description: "The payload to update an existing organization.",
+		Required:    true,
+	},
+}
+
+var CreateInvitationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"email": {
+							Value: openapi3.NewStringSchema().
+								WithFormat("email"),
+						},
+						"roleId": {
+							Value: openapi3.NewUUIDSchema(),
+						},
+						"expiresAt": {
+							Value: openapi3.NewDateTimeSchema(),
+						},
+					},
+					Required: []string{
+						"email",
+						"roleId",
+					},
+				}),
+		},
+		Description: "The payload to invite someone to an organization with a role.",
+		Required:    true,
+	},
+}
+
+var AcceptInvitationSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"token": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"name": {
+							Value: openapi3.NewStringSchema().
+								WithFormat("text"),
+						},
+						"password": {
+							Value: openapi3.NewStringSchema().WithFormat("password").WithMinLength(8),
+						},
+					},
+					Required: []string{
+						"token",
+					},
+				}),
+		},
+		Description: "The payload to accept an organization invitation. A name and password are only needed when the invitation creates a new account.",
+		Required:    true,
+	},
+}
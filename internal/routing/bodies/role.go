@@ -15,6 +15,18 @@ var CreateRoleSchema = &openapi3.RequestBodyRef{
 						"description": {
 							Value: openapi3.NewStringSchema().WithFormat("text").WithMin(3),
 						},
+						"permissions": {
+							Value: &openapi3.Schema{
+								Type: openapi3.NewArraySchema().Type,
+								Items: &openapi3.SchemaRef{
+									Value: openapi3.NewStringSchema().WithFormat("text"),
+								},
+							},
+						},
+						"organizationId": {
+							Value: openapi3.NewUUIDSchema().
+								WithNullable(),
+						},
 					},
 					Required: []string{
 						"name",
@@ -22,7 +34,7 @@ var CreateRoleSchema = &openapi3.RequestBodyRef{
 					},
 				}),
 		},
-		Description: "The payload to create a new role.",
+		Description: "The payload to create a new role. `organizationId` is only honoured for system admins; anyone else creates the role in their active organization.",
 		Required:    true,
 	},
 }
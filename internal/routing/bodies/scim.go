@@ -0,0 +1,131 @@
+package bodies
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var CreateScimTokenSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"name": {
+							Value: openapi3.NewStringSchema().
+								WithFormat("text"),
+						},
+					},
+					Required: []string{
+						"name",
+					},
+				}),
+		},
+		Description: "The payload to create a SCIM provisioning token.",
+		Required:    true,
+	},
+}
+
+var ScimUserSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/scim+json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"schemas": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewStringSchema()),
+						},
+						"userName": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"externalId": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"displayName": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"name": {
+							Value: openapi3.NewObjectSchema().
+								WithProperty("formatted", openapi3.NewStringSchema()).
+								WithProperty("givenName", openapi3.NewStringSchema()).
+								WithProperty("familyName", openapi3.NewStringSchema()),
+						},
+						"emails": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewObjectSchema().
+									WithProperty("value", openapi3.NewStringSchema()).
+									WithProperty("type", openapi3.NewStringSchema()).
+									WithProperty("primary", openapi3.NewBoolSchema())),
+						},
+						"active": {
+							Value: openapi3.NewBoolSchema(),
+						},
+					},
+					Required: []string{
+						"userName",
+					},
+				}),
+		},
+		Description: "A SCIM 2.0 User resource.",
+		Required:    true,
+	},
+}
+
+var ScimGroupSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/scim+json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"schemas": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewStringSchema()),
+						},
+						"displayName": {
+							Value: openapi3.NewStringSchema(),
+						},
+						"members": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewObjectSchema().
+									WithProperty("value", openapi3.NewUUIDSchema())),
+						},
+					},
+					Required: []string{
+						"displayName",
+					},
+				}),
+		},
+		Description: "A SCIM 2.0 Group resource.",
+		Required:    true,
+	},
+}
+
+var ScimPatchSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/scim+json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"schemas": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewStringSchema()),
+						},
+						"Operations": {
+							Value: openapi3.NewArraySchema().
+								WithItems(openapi3.NewObjectSchema().
+									WithProperty("op", openapi3.NewStringSchema().WithEnum("add", "replace", "remove")).
+									WithProperty("path", openapi3.NewStringSchema()).
+									WithProperty("value", &openapi3.Schema{})),
+						},
+					},
+					Required: []string{
+						"Operations",
+					},
+				}),
+		},
+		Description: "A SCIM 2.0 PatchOp request.",
+		Required:    true,
+	},
+}
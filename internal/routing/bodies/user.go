@@ -43,23 +43,9 @@ var UpdateUserSchema = &openapi3.RequestBodyRef{
 				WithSchema(&openapi3.Schema{
 					Type: openapi3.NewObjectSchema().Type,
 					Properties: map[string]*openapi3.SchemaRef{
-						"image": {
-							Value: openapi3.NewStringSchema().
-								WithFormat("uri"),
-						},
 						"name": {
 							Value: openapi3.NewStringSchema().WithFormat("text").WithMin(3),
 						},
-						"username": {
-							Value: openapi3.NewStringSchema().
-								WithPattern(`(?:\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b)|(?:\b(?:\+?\d{1,3}[-.\s]?)?(?:\(?\d{2,4}\)?[-.\s]?)?\d{3,4}[-.\s]?\d{3,4}\b)`),
-						},
-						"mfaEnabled": {
-							Value: openapi3.NewBoolSchema(),
-						},
-						"mfaVerified": {
-							Value: openapi3.NewBoolSchema(),
-						},
 						"bio": {
 							Value: openapi3.NewStringSchema().
 								WithFormat("text").WithNullable(),
@@ -72,3 +58,30 @@ var UpdateUserSchema = &openapi3.RequestBodyRef{
 		Required:    true,
 	},
 }
+
+var UpdateUserTypeSchema = &openapi3.RequestBodyRef{
+	Value: &openapi3.RequestBody{
+		Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().
+				WithSchema(&openapi3.Schema{
+					Type: openapi3.NewObjectSchema().Type,
+					Properties: map[string]*openapi3.SchemaRef{
+						"type": {
+							Value: openapi3.NewStringSchema().
+								WithEnum(
+									"system_admin",
+									"system_user",
+									"organization_owner",
+									"organization_user",
+								),
+						},
+					},
+					Required: []string{
+						"type",
+					},
+				}),
+		},
+		Description: "The payload to change a user's type.",
+		Required:    true,
+	},
+}
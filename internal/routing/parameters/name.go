@@ -0,0 +1,18 @@
+package parameters
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var NameParameter = &openapi3.ParameterRef{
+	Value: &openapi3.Parameter{
+		In:              "query",
+		Name:            "name",
+		Description:     "A friendly name for the new entity.",
+		AllowEmptyValue: false,
+		Required:        false,
+		Schema: &openapi3.SchemaRef{
+			Value: &openapi3.Schema{
+				Type: openapi3.NewStringSchema().Type,
+			},
+		},
+	},
+}
@@ -0,0 +1,18 @@
+package parameters
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var ProviderParameter = &openapi3.ParameterRef{
+	Value: &openapi3.Parameter{
+		In:              "path",
+		Name:            "provider",
+		Description:     "The identity provider id.",
+		AllowEmptyValue: false,
+		Required:        true,
+		Schema: &openapi3.SchemaRef{
+			Value: &openapi3.Schema{
+				Type: openapi3.NewStringSchema().Type,
+			},
+		},
+	},
+}
@@ -0,0 +1,19 @@
+package parameters
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var SystemWideParameter = &openapi3.ParameterRef{
+	Value: &openapi3.Parameter{
+		In:              "query",
+		Name:            "systemWide",
+		Description:     "Skip organization scoping. Only system admins may use it.",
+		AllowEmptyValue: false,
+		Required:        false,
+		Schema: &openapi3.SchemaRef{
+			Value: &openapi3.Schema{
+				Type:    openapi3.NewBoolSchema().Type,
+				Default: false,
+			},
+		},
+	},
+}
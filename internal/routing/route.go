@@ -26,8 +26,18 @@ const (
 
 type Route struct {
 	OpenAPIMetadata
-	Method      RouteMethod
-	Path        string
-	Middlewares []fiber.Handler
-	Handler     fiber.Handler
+	Method              RouteMethod
+	Path                string
+	Middlewares         []fiber.Handler
+	Permissions         []string
+	CustomAuthorization bool
+	ApiTokens           bool
+	Handler             fiber.Handler
+	BodyLimit           int
+}
+
+func (r Route) WithPermissions(permissions ...string) Route {
+	r.Permissions = append(r.Permissions, permissions...)
+
+	return r
 }
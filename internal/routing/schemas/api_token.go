@@ -0,0 +1,60 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var ApiTokenSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"userId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"name": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("text"),
+			},
+			"prefix": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"scopes": {
+				Value: openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()),
+			},
+			"expiresAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"lastUsedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"userId",
+			"name",
+			"prefix",
+			"scopes",
+			"expiresAt",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var ApiTokensSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/ApiToken",
+		},
+	},
+}
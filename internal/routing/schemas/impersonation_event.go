@@ -0,0 +1,63 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var ImpersonationEventSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"actorId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"targetId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"action": {
+				Value: openapi3.NewStringSchema().
+					WithEnum("start", "stop", "expire"),
+			},
+			"reason": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"ipAddress": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"userAgent": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"expiresAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"actorId",
+			"targetId",
+			"action",
+			"reason",
+			"ipAddress",
+			"userAgent",
+			"expiresAt",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var ImpersonationEventsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/ImpersonationEvent",
+		},
+	},
+}
@@ -0,0 +1,71 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var LockoutEventSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"scope": {
+				Value: openapi3.NewStringSchema().
+					WithEnum("account", "ip", "mfa"),
+			},
+			"value": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"userId": {
+				Value: openapi3.NewUUIDSchema().
+					WithNullable(),
+			},
+			"ipAddress": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"reason": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"failures": {
+				Value: openapi3.NewIntegerSchema(),
+			},
+			"lockedUntil": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"unlockedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"unlockedById": {
+				Value: openapi3.NewUUIDSchema().
+					WithNullable(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"scope",
+			"value",
+			"ipAddress",
+			"reason",
+			"failures",
+			"lockedUntil",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var LockoutEventsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/LockoutEvent",
+		},
+	},
+}
@@ -0,0 +1,53 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var OrganizationSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"name": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("text").
+					WithMin(3),
+			},
+			"domain": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("hostname"),
+			},
+			"requireMfa": {
+				Value: openapi3.NewBoolSchema(),
+			},
+			"ownerId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"name",
+			"domain",
+			"requireMfa",
+			"ownerId",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var OrganizationsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/Organization",
+		},
+	},
+}
@@ -0,0 +1,74 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var OrganizationInvitationSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"organizationId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"email": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("email"),
+			},
+			"roleId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"role": {
+				Ref: "#/components/schemas/Role",
+			},
+			"invitedById": {
+				Value: openapi3.NewUUIDSchema().
+					WithNullable(),
+			},
+			"expiresAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"sentAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"acceptedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"acceptedById": {
+				Value: openapi3.NewUUIDSchema().
+					WithNullable(),
+			},
+			"revokedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"organizationId",
+			"email",
+			"roleId",
+			"expiresAt",
+			"sentAt",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var OrganizationInvitationsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/OrganizationInvitation",
+		},
+	},
+}
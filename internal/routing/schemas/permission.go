@@ -0,0 +1,63 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var PermissionSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"name": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"group": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"description": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("text"),
+			},
+			"routes": {
+				Value: openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()),
+			},
+		},
+		Required: []string{
+			"name",
+			"group",
+			"description",
+			"routes",
+		},
+	},
+}
+
+var PermissionGroupSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"name": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"permissions": {
+				Value: &openapi3.Schema{
+					Type: openapi3.NewArraySchema().Type,
+					Items: &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Permission",
+					},
+				},
+			},
+		},
+		Required: []string{
+			"name",
+			"permissions",
+		},
+	},
+}
+
+var PermissionGroupsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/PermissionGroup",
+		},
+	},
+}
@@ -36,6 +36,33 @@ var SuccessSchema = &openapi3.SchemaRef{
 									{
 										Ref: "#/components/schemas/Role",
 									},
+									{
+										Ref: "#/components/schemas/Organization",
+									},
+									{
+										Ref: "#/components/schemas/WebauthnCredential",
+									},
+									{
+										Ref: "#/components/schemas/ApiToken",
+									},
+									{
+										Ref: "#/components/schemas/UserSession",
+									},
+									{
+										Ref: "#/components/schemas/LockoutEvent",
+									},
+									{
+										Ref: "#/components/schemas/ImpersonationEvent",
+									},
+									{
+										Ref: "#/components/schemas/ScimToken",
+									},
+									{
+										Ref: "#/components/schemas/OrganizationInvitation",
+									},
+									{
+										Ref: "#/components/schemas/PermissionGroup",
+									},
 								},
 							},
 						},
@@ -49,6 +76,33 @@ var SuccessSchema = &openapi3.SchemaRef{
 									{
 										Ref: "#/components/schemas/Roles",
 									},
+									{
+										Ref: "#/components/schemas/Organizations",
+									},
+									{
+										Ref: "#/components/schemas/WebauthnCredentials",
+									},
+									{
+										Ref: "#/components/schemas/ApiTokens",
+									},
+									{
+										Ref: "#/components/schemas/UserSessions",
+									},
+									{
+										Ref: "#/components/schemas/LockoutEvents",
+									},
+									{
+										Ref: "#/components/schemas/ImpersonationEvents",
+									},
+									{
+										Ref: "#/components/schemas/ScimTokens",
+									},
+									{
+										Ref: "#/components/schemas/OrganizationInvitations",
+									},
+									{
+										Ref: "#/components/schemas/PermissionGroups",
+									},
 								},
 							},
 						},
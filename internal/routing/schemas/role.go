@@ -27,6 +27,10 @@ var RoleSchema = &openapi3.SchemaRef{
 					},
 				},
 			},
+			"organizationId": {
+				Value: openapi3.NewUUIDSchema().
+					WithNullable(),
+			},
 			"createdAt": {
 				Value: openapi3.NewDateTimeSchema(),
 			},
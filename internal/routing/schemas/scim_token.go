@@ -0,0 +1,51 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var ScimTokenSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"organizationId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"name": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("text"),
+			},
+			"prefix": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"lastUsedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"organizationId",
+			"name",
+			"prefix",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var ScimTokensSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/ScimToken",
+		},
+	},
+}
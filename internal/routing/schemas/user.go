@@ -26,6 +26,16 @@ var UserSchema = &openapi3.SchemaRef{
 				Value: openapi3.NewStringSchema().
 					WithFormat("text"),
 			},
+			"emailVerified": {
+				Value: openapi3.NewBoolSchema(),
+			},
+			"active": {
+				Value: openapi3.NewBoolSchema(),
+			},
+			"externalId": {
+				Value: openapi3.NewStringSchema().
+					WithNullable(),
+			},
 			"mfaEnabled": {
 				Value: openapi3.NewBoolSchema(),
 			},
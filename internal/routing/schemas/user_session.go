@@ -0,0 +1,58 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var UserSessionSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"userId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"ipAddress": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"userAgent": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"device": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"current": {
+				Value: openapi3.NewBoolSchema(),
+			},
+			"lastSeenAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"userId",
+			"ipAddress",
+			"userAgent",
+			"device",
+			"current",
+			"lastSeenAt",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var UserSessionsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/UserSession",
+		},
+	},
+}
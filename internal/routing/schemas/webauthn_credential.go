@@ -0,0 +1,62 @@
+package schemas
+
+import "github.com/getkin/kin-openapi/openapi3"
+
+var WebauthnCredentialSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewObjectSchema().Type,
+		Properties: map[string]*openapi3.SchemaRef{
+			"id": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"userId": {
+				Value: openapi3.NewUUIDSchema(),
+			},
+			"name": {
+				Value: openapi3.NewStringSchema().
+					WithFormat("text"),
+			},
+			"attestationType": {
+				Value: openapi3.NewStringSchema(),
+			},
+			"transports": {
+				Value: openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()),
+			},
+			"signCount": {
+				Value: openapi3.NewInt64Schema(),
+			},
+			"cloneWarning": {
+				Value: openapi3.NewBoolSchema(),
+			},
+			"lastUsedAt": {
+				Value: openapi3.NewDateTimeSchema().
+					WithNullable(),
+			},
+			"createdAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+			"updatedAt": {
+				Value: openapi3.NewDateTimeSchema(),
+			},
+		},
+		Required: []string{
+			"id",
+			"userId",
+			"name",
+			"signCount",
+			"cloneWarning",
+			"createdAt",
+			"updatedAt",
+		},
+	},
+}
+
+var WebauthnCredentialsSchema = &openapi3.SchemaRef{
+	Value: &openapi3.Schema{
+		Type: openapi3.NewArraySchema().Type,
+		Items: &openapi3.SchemaRef{
+			Ref: "#/components/schemas/WebauthnCredential",
+		},
+	},
+}
@@ -0,0 +1,285 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Attribute struct {
+	Column  string
+	Boolean bool
+}
+
+type Filter struct {
+	Query string
+	Args  []any
+}
+
+type filterParser struct {
+	tokens     []string
+	position   int
+	attributes map[string]Attribute
+	args       []any
+}
+
+func ParseFilter(filter string, attributes map[string]Attribute) (*Filter, error) {
+	tokens, err := tokenize(filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &filterParser{
+		tokens:     tokens,
+		attributes: attributes,
+	}
+
+	query, err := parser.or()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.position != len(parser.tokens) {
+		return nil, invalidFilter("unexpected %q", parser.tokens[parser.position])
+	}
+
+	return &Filter{
+		Query: query,
+		Args:  parser.args,
+	}, nil
+}
+
+func (p *filterParser) or() (string, error) {
+	left, err := p.and()
+
+	if err != nil {
+		return "", err
+	}
+
+	for p.keyword("or") {
+		right, err := p.and()
+
+		if err != nil {
+			return "", err
+		}
+
+		left = fmt.Sprintf("(%s OR %s)", left, right)
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) and() (string, error) {
+	left, err := p.unary()
+
+	if err != nil {
+		return "", err
+	}
+
+	for p.keyword("and") {
+		right, err := p.unary()
+
+		if err != nil {
+			return "", err
+		}
+
+		left = fmt.Sprintf("(%s AND %s)", left, right)
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) unary() (string, error) {
+	if p.keyword("not") {
+		expression, err := p.unary()
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("(NOT %s)", expression), nil
+	}
+
+	if p.peek() == "(" {
+		p.position++
+
+		expression, err := p.or()
+
+		if err != nil {
+			return "", err
+		}
+
+		if p.next() != ")" {
+			return "", invalidFilter("missing closing parenthesis")
+		}
+
+		return expression, nil
+	}
+
+	return p.comparison()
+}
+
+func (p *filterParser) comparison() (string, error) {
+	name := p.next()
+
+	if name == "" {
+		return "", invalidFilter("expected an attribute")
+	}
+
+	attribute, ok := p.attributes[strings.ToLower(name)]
+
+	if !ok {
+		return "", invalidFilter("unsupported attribute %q", name)
+	}
+
+	operator := strings.ToLower(p.next())
+
+	if operator == "pr" {
+		if attribute.Boolean {
+			return fmt.Sprintf("(%s IS NOT NULL)", attribute.Column), nil
+		}
+
+		return fmt.Sprintf("(%s IS NOT NULL AND %s <> '')", attribute.Column, attribute.Column), nil
+	}
+
+	value := p.next()
+
+	if value == "" {
+		return "", invalidFilter("expected a value after %q", operator)
+	}
+
+	if attribute.Boolean {
+		var boolean bool
+
+		switch strings.ToLower(value) {
+		case "true", `"true"`:
+			boolean = true
+		case "false", `"false"`:
+			boolean = false
+		default:
+			return "", invalidFilter("%q expects a boolean", name)
+		}
+
+		p.args = append(p.args, boolean)
+
+		switch operator {
+		case "eq":
+			return fmt.Sprintf("(%s = ?)", attribute.Column), nil
+		case "ne":
+			return fmt.Sprintf("(%s <> ?)", attribute.Column), nil
+		}
+
+		return "", invalidFilter("unsupported operator %q for %q", operator, name)
+	}
+
+	if !strings.HasPrefix(value, `"`) {
+		return "", invalidFilter("%q expects a quoted string", name)
+	}
+
+	value = strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`)
+	pattern := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+
+	switch operator {
+	case "eq":
+		p.args = append(p.args, value)
+
+		return fmt.Sprintf("(LOWER(%s) = LOWER(?))", attribute.Column), nil
+	case "ne":
+		p.args = append(p.args, value)
+
+		return fmt.Sprintf("(LOWER(%s) <> LOWER(?))", attribute.Column), nil
+	case "co":
+		p.args = append(p.args, "%"+pattern+"%")
+	case "sw":
+		p.args = append(p.args, pattern+"%")
+	case "ew":
+		p.args = append(p.args, "%"+pattern)
+	case "gt", "ge", "lt", "le":
+		p.args = append(p.args, value)
+
+		return fmt.Sprintf("(%s %s ?)", attribute.Column, map[string]string{
+			"gt": ">",
+			"ge": ">=",
+			"lt": "<",
+			"le": "<=",
+		}[operator]), nil
+	default:
+		return "", invalidFilter("unsupported operator %q", operator)
+	}
+
+	return fmt.Sprintf("(%s ILIKE ?)", attribute.Column), nil
+}
+
+func (p *filterParser) keyword(keyword string) bool {
+	if strings.EqualFold(p.peek(), keyword) {
+		p.position++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *filterParser) peek() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.position]
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+
+	if token != "" {
+		p.position++
+	}
+
+	return token
+}
+
+func tokenize(filter string) ([]string, error) {
+	tokens := []string{}
+
+	for index := 0; index < len(filter); {
+		character := filter[index]
+
+		switch {
+		case character == ' ' || character == '\t':
+			index++
+		case character == '(' || character == ')':
+			tokens = append(tokens, string(character))
+			index++
+		case character == '"':
+			end := index + 1
+
+			for end < len(filter) && (filter[end] != '"' || filter[end-1] == '\\') {
+				end++
+			}
+
+			if end >= len(filter) {
+				return nil, invalidFilter("unterminated string")
+			}
+
+			tokens = append(tokens, filter[index:end+1])
+			index = end + 1
+		default:
+			end := index
+
+			for end < len(filter) && !strings.ContainsRune(" \t()\"", rune(filter[end])) {
+				end++
+			}
+
+			tokens = append(tokens, filter[index:end])
+			index = end
+		}
+	}
+
+	return tokens, nil
+}
+
+func invalidFilter(format string, args ...any) *ScimError {
+	return NewError(http.StatusBadRequest, ErrorTypeInvalidFilter, "Invalid filter: "+fmt.Sprintf(format, args...))
+}
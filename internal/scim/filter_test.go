@@ -0,0 +1,83 @@
+package scim
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		query  string
+		args   []any
+	}{
+		{"eq", `userName eq "Someone@Example.com"`, "(LOWER(users.email) = LOWER(?))", []any{"Someone@Example.com"}},
+		{"attribute names ignore case", `USERNAME EQ "a"`, "(LOWER(users.email) = LOWER(?))", []any{"a"}},
+		{"ne", `displayName ne "a"`, "(LOWER(users.name) <> LOWER(?))", []any{"a"}},
+		{"co", `emails co "example"`, "(users.email ILIKE ?)", []any{"%example%"}},
+		{"sw", `userName sw "some"`, "(users.email ILIKE ?)", []any{"some%"}},
+		{"ew", `userName ew ".com"`, "(users.email ILIKE ?)", []any{"%.com"}},
+		{"like wildcards are escaped", `userName co "50%_o\ff"`, `(users.email ILIKE ?)`, []any{`%50\%\_o\\ff%`}},
+		{"escaped quote", `displayName eq "say \"hi\""`, "(LOWER(users.name) = LOWER(?))", []any{`say "hi"`}},
+		{"pr", `externalId pr`, "(users.external_id IS NOT NULL AND users.external_id <> '')", nil},
+		{"boolean", `active eq false`, "(users.active = ?)", []any{false}},
+		{"quoted boolean", `active ne "true"`, "(users.active <> ?)", []any{true}},
+		{"boolean pr", `active pr`, "(users.active IS NOT NULL)", nil},
+		{"and binds tighter than or", `userName eq "a" or userName eq "b" and active eq true`, "((LOWER(users.email) = LOWER(?)) OR ((LOWER(users.email) = LOWER(?)) AND (users.active = ?)))", []any{"a", "b", true}},
+		{"parentheses", `(userName eq "a" or userName eq "b") and active eq true`, "(((LOWER(users.email) = LOWER(?)) OR (LOWER(users.email) = LOWER(?))) AND (users.active = ?))", []any{"a", "b", true}},
+		{"not", `not (active eq true)`, "(NOT (users.active = ?))", []any{true}},
+		{"dotted attribute", `name.formatted sw "A"`, "(users.name ILIKE ?)", []any{"A%"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := ParseFilter(test.filter, UserAttributes)
+
+			if err != nil {
+				t.Fatalf("ParseFilter(%q) error = %v", test.filter, err)
+			}
+
+			if filter.Query != test.query {
+				t.Errorf("Query = %s, want %s", filter.Query, test.query)
+			}
+
+			if !reflect.DeepEqual(filter.Args, test.args) {
+				t.Errorf("Args = %#v, want %#v", filter.Args, test.args)
+			}
+		})
+	}
+}
+
+func TestParseFilterRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+	}{
+		{"empty", ``},
+		{"unknown attribute", `password eq "a"`},
+		{"column injection", `users.email;drop eq "a"`},
+		{"unquoted string", `userName eq a`},
+		{"missing value", `userName eq`},
+		{"unknown operator", `userName regex "a"`},
+		{"boolean with a string", `active eq "yes"`},
+		{"boolean with co", `active co true`},
+		{"unterminated string", `userName eq "a`},
+		{"missing closing parenthesis", `(userName eq "a"`},
+		{"trailing tokens", `userName eq "a" "b"`},
+		{"dangling and", `userName eq "a" and`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseFilter(test.filter, UserAttributes)
+
+			var scimError *ScimError
+
+			if !errors.As(err, &scimError) || scimError.ScimType != ErrorTypeInvalidFilter || scimError.Status != 400 {
+				t.Errorf("ParseFilter(%q) error = %v, want an invalidFilter error", test.filter, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+)
+
+var GroupAttributes = map[string]Attribute{
+	"id":          {Column: "roles.id::text"},
+	"displayname": {Column: "roles.name"},
+}
+
+var memberFilter = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]+)"\s*\]$`)
+
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	Id          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+type GroupPatch struct {
+	DisplayName *string
+	Add         []string
+	Remove      []string
+	Replace     []string
+	RemoveAll   bool
+}
+
+func FromGroup(role *models.Role, members []models.User, baseUrl string) Group {
+	resource := Group{
+		Schemas:     []string{GroupSchema},
+		Id:          role.Id.String(),
+		DisplayName: role.Name,
+		Members:     []Reference{},
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      role.CreatedAt,
+			LastModified: role.UpdatedAt,
+			Location:     baseUrl + "/Groups/" + role.Id.String(),
+		},
+	}
+
+	for _, member := range members {
+		resource.Members = append(resource.Members, Reference{
+			Value:   member.Id.String(),
+			Display: member.Name,
+			Ref:     baseUrl + "/Users/" + member.Id.String(),
+		})
+	}
+
+	return resource
+}
+
+func (g *Group) MemberIds() []string {
+	memberIds := []string{}
+
+	for _, member := range g.Members {
+		memberIds = append(memberIds, member.Value)
+	}
+
+	return memberIds
+}
+
+func ParseGroupPatch(operations []Operation) (*GroupPatch, error) {
+	patch := &GroupPatch{}
+
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.TrimPrefix(strings.TrimSpace(operation.Path), GroupSchema+":")
+
+		switch {
+		case op != "add" && op != "replace" && op != "remove":
+			return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidSyntax, "Unsupported patch operation \""+operation.Op+"\".")
+		case path == "":
+			if op == "remove" {
+				return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidPath, "A path is required to remove a value.")
+			}
+
+			var group Group
+
+			if err := json.Unmarshal(operation.Value, &group); err != nil {
+				return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "The patch value must be an object when no path is given.")
+			}
+
+			if group.DisplayName != "" {
+				patch.DisplayName = &group.DisplayName
+			}
+
+			if group.Members != nil {
+				patch.members(op, group.MemberIds())
+			}
+		case strings.EqualFold(path, "displayName"):
+			if op == "remove" {
+				return nil, NewError(http.StatusBadRequest, ErrorTypeMutability, "displayName cannot be removed.")
+			}
+
+			displayName, err := parseString(operation.Value)
+
+			if err != nil {
+				return nil, err
+			}
+
+			patch.DisplayName = &displayName
+		case strings.EqualFold(path, "members"):
+			if op == "remove" && len(operation.Value) == 0 {
+				patch.RemoveAll = true
+
+				continue
+			}
+
+			var members []Reference
+
+			if err := json.Unmarshal(operation.Value, &members); err != nil {
+				return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "members must be a list.")
+			}
+
+			patch.members(op, (&Group{Members: members}).MemberIds())
+		case memberFilter.MatchString(path):
+			if op != "remove" {
+				return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidPath, "Filtered member paths can only be removed.")
+			}
+
+			patch.Remove = append(patch.Remove, memberFilter.FindStringSubmatch(path)[1])
+		default:
+			return nil, NewError(http.StatusBadRequest, ErrorTypeInvalidPath, "Unsupported attribute \""+path+"\".")
+		}
+	}
+
+	return patch, nil
+}
+
+func (p *GroupPatch) members(op string, memberIds []string) {
+	switch op {
+	case "add":
+		p.Add = append(p.Add, memberIds...)
+	case "remove":
+		p.Remove = append(p.Remove, memberIds...)
+	case "replace":
+		p.Replace = memberIds
+		p.Add = nil
+		p.Remove = nil
+		p.RemoveAll = false
+	}
+}
@@ -0,0 +1,292 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+)
+
+var UserAttributes = map[string]Attribute{
+	"id":             {Column: "users.id::text"},
+	"username":       {Column: "users.email"},
+	"externalid":     {Column: "users.external_id"},
+	"displayname":    {Column: "users.name"},
+	"name.formatted": {Column: "users.name"},
+	"emails":         {Column: "users.email"},
+	"emails.value":   {Column: "users.email"},
+	"active":         {Column: "users.active", Boolean: true},
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type User struct {
+	Schemas     []string    `json:"schemas"`
+	Id          string      `json:"id,omitempty"`
+	ExternalId  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *Name       `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []Email     `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Groups      []Reference `json:"groups,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+func FromUser(user *models.User, groups []models.Role, baseUrl string) User {
+	active := user.Active
+
+	resource := User{
+		Schemas:     []string{UserSchema},
+		Id:          user.Id.String(),
+		UserName:    user.Email,
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails: []Email{
+			{
+				Value:   user.Email,
+				Type:    "work",
+				Primary: true,
+			},
+		},
+		Active: &active,
+		Groups: []Reference{},
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     baseUrl + "/Users/" + user.Id.String(),
+		},
+	}
+
+	if user.ExternalId != nil {
+		resource.ExternalId = *user.ExternalId
+	}
+
+	for _, group := range groups {
+		resource.Groups = append(resource.Groups, Reference{
+			Value:   group.Id.String(),
+			Display: group.Name,
+			Ref:     baseUrl + "/Groups/" + group.Id.String(),
+		})
+	}
+
+	return resource
+}
+
+func (u *User) Email() string {
+	if strings.Contains(u.UserName, "@") {
+		return strings.TrimSpace(u.UserName)
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return strings.TrimSpace(email.Value)
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return strings.TrimSpace(u.Emails[0].Value)
+	}
+
+	return strings.TrimSpace(u.UserName)
+}
+
+func (u *User) FullName() string {
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return strings.TrimSpace(u.Name.Formatted)
+		}
+
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+
+	if u.DisplayName != "" {
+		return strings.TrimSpace(u.DisplayName)
+	}
+
+	return u.Email()
+}
+
+func (u *User) Apply(user *models.User) error {
+	email := u.Email()
+
+	if email == "" {
+		return NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "userName is required.")
+	}
+
+	user.Email = email
+	user.Name = u.FullName()
+
+	if u.ExternalId != "" {
+		user.ExternalId = &u.ExternalId
+	} else {
+		user.ExternalId = nil
+	}
+
+	if u.Active != nil {
+		user.Active = *u.Active
+	} else {
+		user.Active = true
+	}
+
+	return nil
+}
+
+func ApplyUserPatch(user *models.User, operations []Operation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+
+		if op != "add" && op != "replace" && op != "remove" {
+			return NewError(http.StatusBadRequest, ErrorTypeInvalidSyntax, "Unsupported patch operation \""+operation.Op+"\".")
+		}
+
+		if operation.Path == "" {
+			if op == "remove" {
+				return NewError(http.StatusBadRequest, ErrorTypeInvalidPath, "A path is required to remove a value.")
+			}
+
+			var values map[string]json.RawMessage
+
+			if err := json.Unmarshal(operation.Value, &values); err != nil {
+				return NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "The patch value must be an object when no path is given.")
+			}
+
+			for path, value := range values {
+				if err := applyUserValue(user, op, path, value); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if err := applyUserValue(user, op, operation.Path, operation.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyUserValue(user *models.User, op string, path string, value json.RawMessage) error {
+	path = strings.ToLower(strings.TrimPrefix(path, UserSchema+":"))
+
+	if op == "remove" {
+		switch path {
+		case "externalid":
+			user.ExternalId = nil
+
+			return nil
+		}
+
+		return NewError(http.StatusBadRequest, ErrorTypeMutability, "The attribute \""+path+"\" cannot be removed.")
+	}
+
+	switch path {
+	case "active":
+		active, err := parseBool(value)
+
+		if err != nil {
+			return err
+		}
+
+		user.Active = active
+	case "username", "emails.value", `emails[type eq "work"].value`, "emails[primary eq true].value":
+		email, err := parseString(value)
+
+		if err != nil {
+			return err
+		}
+
+		user.Email = email
+	case "emails":
+		var emails []Email
+
+		if err := json.Unmarshal(value, &emails); err != nil || len(emails) == 0 {
+			return NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "emails must be a non-empty list.")
+		}
+
+		user.Email = (&User{Emails: emails}).Email()
+	case "displayname", "name.formatted":
+		name, err := parseString(value)
+
+		if err != nil {
+			return err
+		}
+
+		user.Name = name
+	case "name":
+		var name Name
+
+		if err := json.Unmarshal(value, &name); err != nil {
+			return NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "name must be an object.")
+		}
+
+		if fullName := (&User{Name: &name}).FullName(); fullName != "" {
+			user.Name = fullName
+		}
+	case "name.givenname", "name.familyname":
+		// Names are stored as a single formatted value, so partial updates are ignored.
+	case "externalid":
+		externalId, err := parseString(value)
+
+		if err != nil {
+			return err
+		}
+
+		user.ExternalId = &externalId
+	default:
+		return NewError(http.StatusBadRequest, ErrorTypeInvalidPath, "Unsupported attribute \""+path+"\".")
+	}
+
+	return nil
+}
+
+func parseString(value json.RawMessage) (string, error) {
+	var text string
+
+	if err := json.Unmarshal(value, &text); err != nil {
+		return "", NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "Expected a string value.")
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+func parseBool(value json.RawMessage) (bool, error) {
+	var boolean bool
+
+	if err := json.Unmarshal(value, &boolean); err == nil {
+		return boolean, nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(value, &text); err == nil {
+		if boolean, err := strconv.ParseBool(text); err == nil {
+			return boolean, nil
+		}
+	}
+
+	return false, NewError(http.StatusBadRequest, ErrorTypeInvalidValue, "Expected a boolean value.")
+}
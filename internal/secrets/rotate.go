@@ -0,0 +1,67 @@
+package secrets
+
+import (
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/google/uuid"
+)
+
+const rotateBatchSize = 100
+
+func Rotate(storage storage.Storage, keyring *Keyring, column Column) (int, error) {
+	type row struct {
+		Id    uuid.UUID
+		Value []byte
+	}
+
+	rotated := 0
+	lastId := uuid.Nil
+
+	for {
+		var rows []row
+
+		if err := storage.Database().
+			Table(column.Table).
+			Select("id", column.Column+" AS value").
+			Where(column.Column+" IS NOT NULL AND id > ?", lastId).
+			Order("id ASC").
+			Limit(rotateBatchSize).
+			Scan(&rows).Error; err != nil {
+			return rotated, err
+		}
+
+		if len(rows) == 0 {
+			return rotated, nil
+		}
+
+		for _, row := range rows {
+			lastId = row.Id
+
+			if keyring.Current(row.Value) {
+				continue
+			}
+
+			plaintext, err := keyring.Decrypt(row.Value)
+
+			if err != nil {
+				return rotated, err
+			}
+
+			encrypted, err := keyring.Encrypt(plaintext)
+
+			if err != nil {
+				return rotated, err
+			}
+
+			result := storage.Database().
+				Table(column.Table).
+				Where("id = ? AND "+column.Column+" = ?", row.Id, row.Value).
+				Update(column.Column, encrypted)
+
+			if result.Error != nil {
+				return rotated, result.Error
+			}
+
+			rotated += int(result.RowsAffected)
+		}
+	}
+}
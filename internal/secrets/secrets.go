@@ -0,0 +1,210 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+)
+
+const version = "v1"
+
+var (
+	ErrNoKeys        = errors.New("no encryption keys configured")
+	ErrUnknownKey    = errors.New("unknown encryption key id")
+	ErrInvalidKey    = errors.New("encryption keys must be 32 bytes encoded as base64")
+	ErrMalformedData = errors.New("malformed encrypted value")
+)
+
+type Column struct {
+	Table  string
+	Column string
+}
+
+var Columns = []Column{
+	{Table: "users", Column: "mfa_secret"},
+	{Table: "signing_keys", Column: "private_key"},
+}
+
+type Keyring struct {
+	keys    map[string]cipher.AEAD
+	current string
+}
+
+var (
+	defaultKeyring *Keyring
+	defaultErr     error
+	defaultOnce    sync.Once
+)
+
+func Load() (*Keyring, error) {
+	keys := map[string][]byte{}
+	current := ""
+
+	for _, entry := range strings.Split(common.EnvString("API_ENCRYPTION_KEYS", ""), ",") {
+		entry = strings.TrimSpace(entry)
+
+		if entry == "" {
+			continue
+		}
+
+		keyId, encodedKey, found := strings.Cut(entry, ":")
+
+		if !found || keyId == "" {
+			return nil, ErrInvalidKey
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+
+		if err != nil {
+			return nil, ErrInvalidKey
+		}
+
+		keys[keyId] = key
+		current = keyId
+	}
+
+	return New(keys, common.EnvString("API_ENCRYPTION_KEY_ID", current))
+}
+
+func New(keys map[string][]byte, current string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	if _, ok := keys[current]; !ok {
+		return nil, ErrUnknownKey
+	}
+
+	keyring := &Keyring{
+		keys:    map[string]cipher.AEAD{},
+		current: current,
+	}
+
+	for keyId, key := range keys {
+		if len(key) != 32 || strings.Contains(keyId, ":") {
+			return nil, ErrInvalidKey
+		}
+
+		block, err := aes.NewCipher(key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := cipher.NewGCM(block)
+
+		if err != nil {
+			return nil, err
+		}
+
+		keyring.keys[keyId] = aead
+	}
+
+	return keyring, nil
+}
+
+func (k *Keyring) Encrypt(plaintext []byte) ([]byte, error) {
+	aead := k.keys[k.current]
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := []byte(fmt.Sprintf("%s:%s:", version, k.current))
+
+	sealed := aead.Seal(nonce, nonce, plaintext, header)
+
+	return append(header, sealed...), nil
+}
+
+func (k *Keyring) Decrypt(value []byte) ([]byte, error) {
+	if !Encrypted(value) {
+		return value, nil
+	}
+
+	keyId, sealed, err := parse(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	aead, ok := k.keys[keyId]
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformedData
+	}
+
+	header := value[:len(value)-len(sealed)]
+
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], header)
+}
+
+func (k *Keyring) Current(value []byte) bool {
+	if !Encrypted(value) {
+		return false
+	}
+
+	keyId, _, err := parse(value)
+
+	return err == nil && keyId == k.current
+}
+
+func Encrypted(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(version+":"))
+}
+
+func Default() (*Keyring, error) {
+	defaultOnce.Do(func() {
+		defaultKeyring, defaultErr = Load()
+	})
+
+	return defaultKeyring, defaultErr
+}
+
+func Encrypt(plaintext []byte) ([]byte, error) {
+	keyring, err := Default()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keyring.Encrypt(plaintext)
+}
+
+func Decrypt(value []byte) ([]byte, error) {
+	if !Encrypted(value) {
+		return value, nil
+	}
+
+	keyring, err := Default()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keyring.Decrypt(value)
+}
+
+func parse(value []byte) (string, []byte, error) {
+	parts := bytes.SplitN(value, []byte(":"), 3)
+
+	if len(parts) != 3 || string(parts[0]) != version || len(parts[1]) == 0 {
+		return "", nil, ErrMalformedData
+	}
+
+	return string(parts[1]), parts[2], nil
+}
@@ -0,0 +1,169 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"testing"
+)
+
+func key(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, 32)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	keyring, err := New(map[string][]byte{"a": key(1)}, "a")
+
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	encrypted, err := keyring.Encrypt([]byte("secret"))
+
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	if !bytes.HasPrefix(encrypted, []byte("v1:a:")) {
+		t.Fatalf("Encrypt() = %q, want a v1:a: header", encrypted)
+	}
+
+	if bytes.Contains(encrypted, []byte("secret")) {
+		t.Fatal("Encrypt() leaked the plaintext")
+	}
+
+	decrypted, err := keyring.Decrypt(encrypted)
+
+	if err != nil || string(decrypted) != "secret" {
+		t.Fatalf("Decrypt() = %q, %v", decrypted, err)
+	}
+
+	again, _ := keyring.Encrypt([]byte("secret"))
+
+	if bytes.Equal(encrypted, again) {
+		t.Fatal("Encrypt() reused a nonce")
+	}
+}
+
+func TestRotation(t *testing.T) {
+	old, _ := New(map[string][]byte{"a": key(1)}, "a")
+	rotated, err := New(map[string][]byte{"a": key(1), "b": key(2)}, "b")
+
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	encrypted, _ := old.Encrypt([]byte("secret"))
+
+	if rotated.Current(encrypted) {
+		t.Fatal("Current() = true for a value under the previous key")
+	}
+
+	decrypted, err := rotated.Decrypt(encrypted)
+
+	if err != nil || string(decrypted) != "secret" {
+		t.Fatalf("Decrypt() with the previous key = %q, %v", decrypted, err)
+	}
+
+	reencrypted, _ := rotated.Encrypt(decrypted)
+
+	if !rotated.Current(reencrypted) {
+		t.Fatal("Current() = false for a value under the current key")
+	}
+
+	if _, err := old.Decrypt(reencrypted); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Decrypt() with a retired keyring error = %v, want %v", err, ErrUnknownKey)
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	keyring, _ := New(map[string][]byte{"a": key(1), "b": key(2)}, "a")
+	encrypted, _ := keyring.Encrypt([]byte("secret"))
+
+	flipped := bytes.Clone(encrypted)
+	flipped[len(flipped)-1] ^= 1
+
+	if _, err := keyring.Decrypt(flipped); err == nil {
+		t.Error("Decrypt() accepted a modified ciphertext")
+	}
+
+	relabelled := append([]byte("v1:b:"), encrypted[len("v1:a:"):]...)
+
+	if _, err := keyring.Decrypt(relabelled); err == nil {
+		t.Error("Decrypt() accepted a value moved to another key id")
+	}
+
+	if _, err := keyring.Decrypt([]byte("v1::abc")); !errors.Is(err, ErrMalformedData) {
+		t.Errorf("Decrypt() of a malformed value error = %v, want %v", err, ErrMalformedData)
+	}
+}
+
+func TestDecryptPassesPlaintextThrough(t *testing.T) {
+	keyring, _ := New(map[string][]byte{"a": key(1)}, "a")
+
+	decrypted, err := keyring.Decrypt([]byte("JBSWY3DPEHPK3PXP"))
+
+	if err != nil || string(decrypted) != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("Decrypt() = %q, %v", decrypted, err)
+	}
+
+	if keyring.Current([]byte("JBSWY3DPEHPK3PXP")) {
+		t.Fatal("Current() = true for an unencrypted value")
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    map[string][]byte
+		current string
+		want    error
+	}{
+		{"no keys", nil, "", ErrNoKeys},
+		{"unknown current key", map[string][]byte{"a": key(1)}, "b", ErrUnknownKey},
+		{"short key", map[string][]byte{"a": key(1)[:16]}, "a", ErrInvalidKey},
+		{"separator in key id", map[string][]byte{"a:b": key(1)}, "a:b", ErrInvalidKey},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := New(test.keys, test.current); !errors.Is(err, test.want) {
+				t.Errorf("New() error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("API_ENCRYPTION_KEYS", "a:"+base64.StdEncoding.EncodeToString(key(1))+", b:"+base64.StdEncoding.EncodeToString(key(2)))
+	t.Setenv("API_ENCRYPTION_KEY_ID", "a")
+
+	keyring, err := Load()
+
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if keyring.current != "a" {
+		t.Errorf("current = %q, want the key named by API_ENCRYPTION_KEY_ID", keyring.current)
+	}
+
+	os.Unsetenv("API_ENCRYPTION_KEY_ID")
+
+	keyring, err = Load()
+
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if keyring.current != "b" {
+		t.Errorf("current = %q, want the last listed key", keyring.current)
+	}
+
+	t.Setenv("API_ENCRYPTION_KEYS", "a:not-base64")
+
+	if _, err := Load(); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Load() error = %v, want %v", err, ErrInvalidKey)
+	}
+}
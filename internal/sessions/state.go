@@ -0,0 +1,177 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/models"
+	"github.com/connor-davis/dialogue-video-analysis-tool/internal/storage"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/session"
+	"github.com/google/uuid"
+)
+
+const (
+	TableName = "sessions"
+
+	UserIdKey           = "user_id"
+	MfaPendingUserIdKey = "mfa_pending_user_id"
+	MfaVerifiedKey      = "mfa_verified"
+
+	ImpersonatorIdKey         = "impersonator_id"
+	ImpersonationExpiresAtKey = "impersonation_expires_at"
+
+	PasswordlessNonceKey = "passwordless_nonce"
+
+	OrganizationIdKey = "organization_id"
+)
+
+func Authenticate(ctx fiber.Ctx, storage storage.Storage, userId uuid.UUID) error {
+	session := session.FromContext(ctx)
+
+	if err := regenerate(ctx, storage, userId); err != nil {
+		return err
+	}
+
+	session.Delete(MfaPendingUserIdKey)
+	session.Delete(MfaVerifiedKey)
+	session.Set(UserIdKey, userId.String())
+
+	return nil
+}
+
+func CompleteMfa(ctx fiber.Ctx, storage storage.Storage, userId uuid.UUID) error {
+	session := session.FromContext(ctx)
+
+	impersonatorId, _ := session.Get(ImpersonatorIdKey).(string)
+	impersonationExpiresAt := session.Get(ImpersonationExpiresAtKey)
+
+	sessionUserId := userId
+
+	if actorId, err := uuid.Parse(impersonatorId); err == nil {
+		sessionUserId = actorId
+	}
+
+	if err := regenerate(ctx, storage, sessionUserId); err != nil {
+		return err
+	}
+
+	session.Delete(MfaPendingUserIdKey)
+	session.Set(UserIdKey, userId.String())
+	session.Set(MfaVerifiedKey, true)
+
+	if impersonatorId != "" {
+		session.Set(ImpersonatorIdKey, impersonatorId)
+		session.Set(ImpersonationExpiresAtKey, impersonationExpiresAt)
+	}
+
+	return nil
+}
+
+func MfaVerified(ctx fiber.Ctx) bool {
+	verified, ok := session.FromContext(ctx).Get(MfaVerifiedKey).(bool)
+
+	return ok && verified
+}
+
+func Challenge(ctx fiber.Ctx, storage storage.Storage, userId uuid.UUID) error {
+	session := session.FromContext(ctx)
+
+	if err := regenerate(ctx, storage, userId); err != nil {
+		return err
+	}
+
+	session.Delete(UserIdKey)
+	session.Delete(MfaVerifiedKey)
+	session.Set(MfaPendingUserIdKey, userId.String())
+
+	return nil
+}
+
+func RevokeAll(storage storage.Storage, userId uuid.UUID, exceptSessionIds ...string) error {
+	query := storage.Database().
+		Model(&models.UserSession{}).
+		Where("user_id = ?", userId)
+
+	if len(exceptSessionIds) > 0 {
+		query = query.Where("session_id NOT IN ?", exceptSessionIds)
+	}
+
+	var sessionIds []string
+
+	if err := query.Pluck("session_id", &sessionIds).Error; err != nil {
+		return err
+	}
+
+	return Revoke(storage, sessionIds...)
+}
+
+func Revoke(storage storage.Storage, sessionIds ...string) error {
+	if len(sessionIds) == 0 {
+		return nil
+	}
+
+	if err := storage.Database().
+		Exec("DELETE FROM "+TableName+" WHERE k IN ?", sessionIds).Error; err != nil {
+		return err
+	}
+
+	return storage.Database().
+		Where("session_id IN ?", sessionIds).
+		Delete(&models.UserSession{}).Error
+}
+
+func regenerate(ctx fiber.Ctx, storage storage.Storage, userId uuid.UUID) error {
+	session := session.FromContext(ctx)
+
+	previousSessionId := session.ID()
+
+	if err := session.Regenerate(); err != nil {
+		return err
+	}
+
+	session.Delete(ImpersonatorIdKey)
+	session.Delete(ImpersonationExpiresAtKey)
+
+	if err := storage.Database().
+		Where("session_id = ?", previousSessionId).
+		Delete(&models.UserSession{}).Error; err != nil {
+		return err
+	}
+
+	return storage.Database().Create(&models.UserSession{
+		SessionId:  session.ID(),
+		UserId:     userId,
+		IpAddress:  ctx.IP(),
+		UserAgent:  string(ctx.Request().Header.UserAgent()),
+		LastSeenAt: time.Now(),
+	}).Error
+}
+
+func Touch(ctx fiber.Ctx, storage storage.Storage) error {
+	return storage.Database().
+		Model(&models.UserSession{}).
+		Where("session_id = ? AND last_seen_at < ?", session.FromContext(ctx).ID(), time.Now().Add(-time.Minute)).
+		Updates(map[string]any{
+			"last_seen_at": time.Now(),
+			"ip_address":   ctx.IP(),
+		}).Error
+}
+
+func List(storage storage.Storage, userId uuid.UUID) ([]models.UserSession, error) {
+	var userSessions []models.UserSession
+
+	if err := storage.Database().
+		Joins("JOIN "+TableName+" ON "+TableName+".k = user_sessions.session_id").
+		Where("user_sessions.user_id = ?", userId).
+		Where("("+TableName+".e = 0 OR "+TableName+".e > ?)", time.Now().Unix()).
+		Order("user_sessions.last_seen_at DESC").
+		Find(&userSessions).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range userSessions {
+		userSessions[i].Device = Device(userSessions[i].UserAgent)
+	}
+
+	return userSessions, nil
+}
@@ -0,0 +1,210 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/connor-davis/dialogue-video-analysis-tool/common"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gofiber/fiber/v3/log"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown sso provider")
+	ErrMissingIdToken  = errors.New("token response did not include an id_token")
+	ErrInvalidNonce    = errors.New("id_token nonce does not match")
+)
+
+type Provider struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	Issuer       string   `json:"-"`
+	ClientId     string   `json:"-"`
+	ClientSecret string   `json:"-"`
+	Scopes       []string `json:"-"`
+	RedirectUrl  string   `json:"-"`
+}
+
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type Sso interface {
+	Providers() []Provider
+	AuthCodeUrl(ctx context.Context, providerId string, state string, nonce string, verifier string) (string, error)
+	Exchange(ctx context.Context, providerId string, code string, nonce string, verifier string) (*Identity, error)
+}
+
+type sso struct {
+	providers  []Provider
+	discovered map[string]*oidc.Provider
+	mutex      sync.Mutex
+}
+
+func New() Sso {
+	providers := []Provider{}
+
+	for _, id := range strings.Split(common.EnvString("API_OIDC_PROVIDERS", ""), ",") {
+		id = strings.ToLower(strings.TrimSpace(id))
+
+		if id == "" {
+			continue
+		}
+
+		prefix := fmt.Sprintf("API_OIDC_%s_", strings.ToUpper(strings.ReplaceAll(id, "-", "_")))
+
+		issuer := strings.TrimSuffix(
+			common.EnvString(prefix+"DISCOVERY_URL", ""),
+			"/.well-known/openid-configuration",
+		)
+
+		if issuer == "" || common.EnvString(prefix+"CLIENT_ID", "") == "" {
+			log.Warnf("🚫 OIDC provider %s is missing a discovery url or client id, skipping.", id)
+
+			continue
+		}
+
+		providers = append(providers, Provider{
+			Id:           id,
+			Name:         common.EnvString(prefix+"NAME", id),
+			Issuer:       issuer,
+			ClientId:     common.EnvString(prefix+"CLIENT_ID", ""),
+			ClientSecret: common.EnvString(prefix+"CLIENT_SECRET", ""),
+			Scopes:       strings.Fields(common.EnvString(prefix+"SCOPES", "openid email profile")),
+			RedirectUrl: common.EnvString(
+				prefix+"REDIRECT_URL",
+				fmt.Sprintf("%s/api/v1/authentication/sso/%s/callback", apiBaseUrl(), id),
+			),
+		})
+	}
+
+	return &sso{
+		providers:  providers,
+		discovered: map[string]*oidc.Provider{},
+	}
+}
+
+func (s *sso) Providers() []Provider {
+	return s.providers
+}
+
+func (s *sso) AuthCodeUrl(ctx context.Context, providerId string, state string, nonce string, verifier string) (string, error) {
+	_, config, err := s.config(ctx, providerId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return config.AuthCodeURL(
+		state,
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(verifier),
+	), nil
+}
+
+func (s *sso) Exchange(ctx context.Context, providerId string, code string, nonce string, verifier string) (*Identity, error) {
+	provider, config, err := s.config(ctx, providerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawIdToken, ok := token.Extra("id_token").(string)
+
+	if !ok || rawIdToken == "" {
+		return nil, ErrMissingIdToken
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{
+		ClientID: config.ClientID,
+	}).Verify(ctx, rawIdToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, ErrInvalidNonce
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Provider:      providerId,
+		Subject:       idToken.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+	}, nil
+}
+
+func (s *sso) config(ctx context.Context, providerId string) (*oidc.Provider, *oauth2.Config, error) {
+	var provider *Provider
+
+	for i := range s.providers {
+		if s.providers[i].Id == providerId {
+			provider = &s.providers[i]
+
+			break
+		}
+	}
+
+	if provider == nil {
+		return nil, nil, ErrUnknownProvider
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	discovered, ok := s.discovered[provider.Id]
+
+	if !ok {
+		var err error
+
+		discovered, err = oidc.NewProvider(ctx, provider.Issuer)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		s.discovered[provider.Id] = discovered
+	}
+
+	return discovered, &oauth2.Config{
+		ClientID:     provider.ClientId,
+		ClientSecret: provider.ClientSecret,
+		Endpoint:     discovered.Endpoint(),
+		RedirectURL:  provider.RedirectUrl,
+		Scopes:       provider.Scopes,
+	}, nil
+}
+
+func apiBaseUrl() string {
+	if common.EnvString("API_MODE", "development") == "production" {
+		return common.EnvString("API_BASE_URL", "https://example.com")
+	}
+
+	return fmt.Sprintf("http://localhost:%s", common.EnvString("API_PORT", "6173"))
+}
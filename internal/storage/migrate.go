@@ -10,10 +10,34 @@ func (s *storage) Migrate() error {
 		&models.User{},
 		&models.Role{},
 		&models.Organization{},
+		&models.EmailVerification{},
+		&models.PasswordReset{},
+		&models.UserSession{},
+		&models.MfaRecoveryCode{},
+		&models.WebauthnCredential{},
+		&models.UserIdentity{},
+		&models.ApiToken{},
+		&models.AuthenticationAttempt{},
+		&models.LockoutEvent{},
+		&models.ImpersonationEvent{},
+		&models.LoginCode{},
+		&models.ScimToken{},
+		&models.SigningKey{},
+		&models.RefreshToken{},
+		&models.PasswordHistory{},
+		&models.OrganizationInvitation{},
 	); err != nil {
 		return err
 	}
 
+	// Role names used to be unique across every organization. They are now
+	// unique per organization, and among the global roles.
+	if s.database.Migrator().HasIndex(&models.Role{}, "idx_roles_name") {
+		if err := s.database.Migrator().DropIndex(&models.Role{}, "idx_roles_name"); err != nil {
+			return err
+		}
+	}
+
 	log.Info("✅ Database migration completed successfully.")
 
 	return nil
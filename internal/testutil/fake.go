@@ -0,0 +1,220 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Statement is a query or command the fake database received, with its
+// bound arguments.
+type Statement struct {
+	Query string
+	Args  []any
+}
+
+// Contains reports whether the statement's SQL contains every fragment.
+func (s Statement) Contains(fragments ...string) bool {
+	for _, fragment := range fragments {
+		if !strings.Contains(s.Query, fragment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rows is the result set the fake database answers a query with.
+type Rows struct {
+	Columns []string
+	Values  [][]any
+}
+
+// Row answers with a single row holding the given columns.
+func Row(columns map[string]any) *Rows {
+	rows := &Rows{Values: [][]any{{}}}
+
+	for column := range columns {
+		rows.Columns = append(rows.Columns, column)
+	}
+
+	slices.Sort(rows.Columns)
+
+	for _, column := range rows.Columns {
+		rows.Values[0] = append(rows.Values[0], columns[column])
+	}
+
+	return rows
+}
+
+// Column answers with one row per value in a single column.
+func Column(name string, values ...any) *Rows {
+	rows := &Rows{Columns: []string{name}}
+
+	for _, value := range values {
+		rows.Values = append(rows.Values, []any{value})
+	}
+
+	return rows
+}
+
+// Count answers a COUNT(*) query.
+func Count(count int64) *Rows {
+	return Column("count", count)
+}
+
+// Database is a scripted database for code that needs real query results or
+// RowsAffected, which a dry run database cannot give. Query answers SELECTs
+// and RETURNING statements, and Exec returns the rows affected by every other
+// statement. Either may be nil, answering with no rows.
+type Database struct {
+	Query func(statement Statement) *Rows
+	Exec  func(statement Statement) int64
+
+	mutex      sync.Mutex
+	statements []Statement
+}
+
+// Statements returns every statement received so far, including BEGIN,
+// COMMIT and ROLLBACK.
+func (d *Database) Statements() []Statement {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return slices.Clone(d.statements)
+}
+
+// Executed reports whether any statement contained every fragment.
+func (d *Database) Executed(fragments ...string) bool {
+	return slices.ContainsFunc(d.Statements(), func(statement Statement) bool {
+		return statement.Contains(fragments...)
+	})
+}
+
+func (d *Database) record(query string, args []driver.NamedValue) Statement {
+	statement := Statement{Query: query}
+
+	for _, arg := range args {
+		statement.Args = append(statement.Args, arg.Value)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.statements = append(d.statements, statement)
+
+	return statement
+}
+
+// Fake opens storage backed by database.
+func Fake(t *testing.T, database *Database) *Storage {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: sql.OpenDB(&connector{database: database}),
+	}), &gorm.Config{
+		DisableAutomaticPing: true,
+	})
+
+	if err != nil {
+		t.Fatalf("failed to open fake database: %v", err)
+	}
+
+	return &Storage{database: db}
+}
+
+type connector struct {
+	database *Database
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{database: c.database}, nil
+}
+
+func (c *connector) Driver() driver.Driver { return nil }
+
+type conn struct {
+	database *Database
+}
+
+func (c *conn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *conn) Close() error                        { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.database.record("BEGIN", nil)
+
+	return c, nil
+}
+
+func (c *conn) Commit() error {
+	c.database.record("COMMIT", nil)
+
+	return nil
+}
+
+func (c *conn) Rollback() error {
+	c.database.record("ROLLBACK", nil)
+
+	return nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	statement := c.database.record(query, args)
+
+	if c.database.Exec == nil {
+		return driver.RowsAffected(0), nil
+	}
+
+	return driver.RowsAffected(c.database.Exec(statement)), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	statement := c.database.record(query, args)
+
+	var answer *Rows
+
+	if c.database.Query != nil {
+		answer = c.database.Query(statement)
+	}
+
+	if answer == nil {
+		answer = &Rows{Columns: []string{"id"}}
+	}
+
+	return &rows{columns: answer.Columns, values: slices.Clone(answer.Values)}, nil
+}
+
+type rows struct {
+	columns []string
+	values  [][]any
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	for index, value := range r.values[0] {
+		dest[index] = value
+	}
+
+	r.values = r.values[1:]
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package testutil holds the database doubles shared by the tests.
+package testutil
+
+import (
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Storage implements storage.Storage over a test database.
+type Storage struct {
+	database *gorm.DB
+}
+
+func (s *Storage) Database() *gorm.DB { return s.database }
+func (s *Storage) Migrate() error     { return nil }
+func (s *Storage) Seed() error        { return nil }
+
+// DryRun opens storage that builds SQL without running it. Queries find no
+// rows, and writes succeed without touching anything.
+func DryRun(t *testing.T) *Storage {
+	t.Helper()
+
+	database, err := gorm.Open(postgres.New(postgres.Config{
+		DSN:                  "host=localhost user=postgres dbname=test sslmode=disable",
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+
+	if err != nil {
+		t.Fatalf("failed to open dry run database: %v", err)
+	}
+
+	return &Storage{database: database}
+}
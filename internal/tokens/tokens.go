@@ -0,0 +1,43 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/big"
+	"strings"
+)
+
+func Generate() (string, []byte, error) {
+	buffer := make([]byte, 32)
+
+	if _, err := rand.Read(buffer); err != nil {
+		return "", nil, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buffer)
+
+	return token, Hash(token), nil
+}
+
+func Hash(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+
+	return sum[:]
+}
+
+func Code(digits int) (string, error) {
+	var code strings.Builder
+
+	for range digits {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+
+		if err != nil {
+			return "", err
+		}
+
+		code.WriteString(digit.String())
+	}
+
+	return code.String(), nil
+}